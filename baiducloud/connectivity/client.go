@@ -6,6 +6,7 @@ import (
 	"github.com/baidubce/bce-sdk-go/auth"
 	"github.com/baidubce/bce-sdk-go/services/appblb"
 	"github.com/baidubce/bce-sdk-go/services/bcc"
+	"github.com/baidubce/bce-sdk-go/services/blb"
 	"github.com/baidubce/bce-sdk-go/services/bos"
 	"github.com/baidubce/bce-sdk-go/services/cce"
 	ccev2 "github.com/baidubce/bce-sdk-go/services/cce/v2"
@@ -13,12 +14,14 @@ import (
 	"github.com/baidubce/bce-sdk-go/services/cfc"
 	"github.com/baidubce/bce-sdk-go/services/dts"
 	"github.com/baidubce/bce-sdk-go/services/eip"
+	"github.com/baidubce/bce-sdk-go/services/etGateway"
 	"github.com/baidubce/bce-sdk-go/services/iam"
 	"github.com/baidubce/bce-sdk-go/services/rds"
 	"github.com/baidubce/bce-sdk-go/services/scs"
 	"github.com/baidubce/bce-sdk-go/services/sts"
 	"github.com/baidubce/bce-sdk-go/services/sts/api"
 	"github.com/baidubce/bce-sdk-go/services/vpc"
+	"github.com/baidubce/bce-sdk-go/services/vpn"
 	"github.com/baidubce/bce-sdk-go/util/log"
 )
 
@@ -34,6 +37,7 @@ type BaiduClient struct {
 	vpcConn    *vpc.Client
 	eipConn    *eip.Client
 	appBlbConn *appblb.Client
+	blbConn    *blb.Client
 	bosConn    *bos.Client
 	certConn   *cert.Client
 	cfcConn    *cfc.Client
@@ -43,6 +47,8 @@ type BaiduClient struct {
 	rdsConn    *rds.Client
 	dtsConn    *dts.Client
 	iamConn    *iam.Client
+	vpnConn    *vpn.Client
+	etConn     *etGateway.Client
 }
 
 type ApiVersion string
@@ -192,6 +198,25 @@ func (client *BaiduClient) WithAppBLBClient(do func(*appblb.Client) (interface{}
 	return do(client.appBlbConn)
 }
 
+func (client *BaiduClient) WithBLBClient(do func(*blb.Client) (interface{}, error)) (interface{}, error) {
+	goSdkMutex.Lock()
+	defer goSdkMutex.Unlock()
+
+	// Initialize the BLB client if necessary, classic BLB shares the endpoint with APPBLB
+	if client.blbConn == nil {
+		client.WithCommonClient(APPBLBCode)
+		blbClient, err := blb.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
+		if err != nil {
+			return nil, err
+		}
+		blbClient.Config.Credentials = client.Credentials
+
+		client.blbConn = blbClient
+	}
+
+	return do(client.blbConn)
+}
+
 func (client *BaiduClient) WithBosClient(do func(*bos.Client) (interface{}, error)) (interface{}, error) {
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
@@ -211,6 +236,23 @@ func (client *BaiduClient) WithBosClient(do func(*bos.Client) (interface{}, erro
 	return do(client.bosConn)
 }
 
+// NewBosRegionClient creates a BOS client of the given region to access the buckets out of the provider region,
+// the client is not shared so that it can be used without holding the sdk mutex
+func (client *BaiduClient) NewBosRegionClient(region Region) (*bos.Client, error) {
+	endpoint := loadEndpoint(region, BOSCode)
+	if endpoint == "" {
+		endpoint = string(region) + ".bcebos.com"
+	}
+
+	bosClient, err := bos.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	bosClient.Config.Credentials = client.Credentials
+
+	return bosClient, nil
+}
+
 func (client *BaiduClient) WithCertClient(do func(*cert.Client) (interface{}, error)) (interface{}, error) {
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
@@ -360,3 +402,41 @@ func (client *BaiduClient) WithIamClient(do func(*iam.Client) (interface{}, erro
 
 	return do(client.iamConn)
 }
+
+func (client *BaiduClient) WithVpnClient(do func(*vpn.Client) (interface{}, error)) (interface{}, error) {
+	goSdkMutex.Lock()
+	defer goSdkMutex.Unlock()
+
+	// Initialize the VPN client if necessary
+	if client.vpnConn == nil {
+		client.WithCommonClient(VPNCode)
+		vpnClient, err := vpn.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
+		if err != nil {
+			return nil, err
+		}
+		vpnClient.Config.Credentials = client.Credentials
+
+		client.vpnConn = vpnClient
+	}
+
+	return do(client.vpnConn)
+}
+
+func (client *BaiduClient) WithEtGatewayClient(do func(*etGateway.Client) (interface{}, error)) (interface{}, error) {
+	goSdkMutex.Lock()
+	defer goSdkMutex.Unlock()
+
+	// Initialize the ET gateway client if necessary
+	if client.etConn == nil {
+		client.WithCommonClient(ETCode)
+		etClient, err := etGateway.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
+		if err != nil {
+			return nil, err
+		}
+		etClient.Config.Credentials = client.Credentials
+
+		client.etConn = etClient
+	}
+
+	return do(client.etConn)
+}
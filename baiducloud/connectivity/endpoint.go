@@ -25,6 +25,8 @@ const (
 	RDSCode    = ServiceCode("RDS")
 	DTSCode    = ServiceCode("DTS")
 	IAMCode    = ServiceCode("IAM")
+	VPNCode    = ServiceCode("VPN")
+	ETCode     = ServiceCode("ET")
 )
 
 const (
@@ -82,6 +84,8 @@ var (
 	RegionBJEndpoints = map[ServiceCode]string{
 		BCCCode:    DefaultBJRegionBccEndPoint,
 		VPCCode:    DefaultBJRegionBccEndPoint,
+		VPNCode:    DefaultBJRegionBccEndPoint,
+		ETCode:     DefaultBJRegionBccEndPoint,
 		EIPCode:    DefaultBJRegionEipEndPoint,
 		APPBLBCode: DefaultBJRegionBlbEndPoint,
 		BOSCode:    DefaultBJRegionBosEndPoint,
@@ -99,6 +103,8 @@ var (
 	RegionGZEndpoints = map[ServiceCode]string{
 		BCCCode:    DefaultGZRegionBccEndPoint,
 		VPCCode:    DefaultGZRegionBccEndPoint,
+		VPNCode:    DefaultGZRegionBccEndPoint,
+		ETCode:     DefaultGZRegionBccEndPoint,
 		EIPCode:    DefaultGZRegionEipEndPoint,
 		APPBLBCode: DefaultGZRegionBlbEndPoint,
 		BOSCode:    DefaultGZRegionBosEndPoint,
@@ -115,6 +121,8 @@ var (
 	RegionSUEndpoints = map[ServiceCode]string{
 		BCCCode:    DefaultSURegionBccEndPoint,
 		VPCCode:    DefaultSURegionBccEndPoint,
+		VPNCode:    DefaultSURegionBccEndPoint,
+		ETCode:     DefaultSURegionBccEndPoint,
 		EIPCode:    DefaultSURegionEipEndPoint,
 		APPBLBCode: DefaultSURegionBlbEndPoint,
 		BOSCode:    DefaultSURegionBosEndPoint,
@@ -131,6 +139,8 @@ var (
 	RegionFWHEndpoints = map[ServiceCode]string{
 		BCCCode:    DefaultFWHRegionBccEndPoint,
 		VPCCode:    DefaultFWHRegionBccEndPoint,
+		VPNCode:    DefaultFWHRegionBccEndPoint,
+		ETCode:     DefaultFWHRegionBccEndPoint,
 		EIPCode:    DefaultFWHRegionEipEndPoint,
 		APPBLBCode: DefaultFWHRegionBlbEndPoint,
 		BOSCode:    DefaultFWHRegionBosEndPoint,
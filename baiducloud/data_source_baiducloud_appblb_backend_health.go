@@ -0,0 +1,196 @@
+/*
+Use this data source to query the health status of the backend servers in an APPBLB Server Group.
+
+Example Usage
+
+```hcl
+data "baiducloud_appblb_backend_health" "default" {
+  blb_id          = "lb-0d29a3f6"
+  server_group_id = "sg-5b9b7d9e"
+}
+
+output "all_healthy" {
+  value = "${data.baiducloud_appblb_backend_health.default.all_healthy}"
+}
+```
+*/
+package baiducloud
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudAppBLBBackendHealth() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudAppBLBBackendHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"blb_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the LoadBalance instance to be queried",
+				Required:    true,
+			},
+			"server_group_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the Server Group to be queried",
+				Required:    true,
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Query result output file path",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"filter": dataSourceFiltersSchema(),
+
+			// Attributes used for result
+			"all_healthy": {
+				Type:        schema.TypeBool,
+				Description: "Whether all the mounted backend servers are healthy, false if there is no backend server",
+				Computed:    true,
+			},
+			"backend_servers": {
+				Type:        schema.TypeList,
+				Description: "Backend servers mounted to the Server Group",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Description: "Backend server instance ID",
+							Computed:    true,
+						},
+						"weight": {
+							Type:        schema.TypeInt,
+							Description: "Backend server instance weight in this group",
+							Computed:    true,
+						},
+						"private_ip": {
+							Type:        schema.TypeString,
+							Description: "Backend server instance bind private ip",
+							Computed:    true,
+						},
+						"healthy": {
+							Type:        schema.TypeBool,
+							Description: "Whether all the ports of the backend server are Alive",
+							Computed:    true,
+						},
+						"port_list": {
+							Type:        schema.TypeList,
+							Description: "Backend server port list",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"listener_port": {
+										Type:        schema.TypeInt,
+										Description: "Listener port",
+										Computed:    true,
+									},
+									"backend_port": {
+										Type:        schema.TypeInt,
+										Description: "Backend open port",
+										Computed:    true,
+									},
+									"port_type": {
+										Type:        schema.TypeString,
+										Description: "Port protocol type",
+										Computed:    true,
+									},
+									"health_check_port_type": {
+										Type:        schema.TypeString,
+										Description: "Health check port protocol type",
+										Computed:    true,
+									},
+									"status": {
+										Type:        schema.TypeString,
+										Description: "Port health check status, include Alive/Dead/Unknown",
+										Computed:    true,
+									},
+									"port_id": {
+										Type:        schema.TypeString,
+										Description: "Port id",
+										Computed:    true,
+									},
+									"policy_id": {
+										Type:        schema.TypeString,
+										Description: "Port bind policy id",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"unmounted_servers": {
+				Type:        schema.TypeList,
+				Description: "Instances which can be mounted but are not mounted to the Server Group",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Description: "Instance ID",
+							Computed:    true,
+						},
+						"private_ip": {
+							Type:        schema.TypeString,
+							Description: "Instance private ip",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudAppBLBBackendHealthRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	appblbService := APPBLBService{client}
+
+	blbId := d.Get("blb_id").(string)
+	sgId := d.Get("server_group_id").(string)
+	action := "Query APPBLB " + blbId + " Server Group " + sgId + " Backend Health"
+
+	mounted, unmounted, err := appblbService.AppServerGroupRsMount(blbId, sgId)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_backend_health", action, BCESDKGoERROR)
+	}
+
+	servers := appblbService.FlattenAppBackendServersHealthToMap(mounted)
+	FilterDataSourceResult(d, &servers)
+
+	allHealthy := len(servers) > 0
+	for _, server := range servers {
+		allHealthy = allHealthy && server["healthy"].(bool)
+	}
+
+	unmountedServers := make([]map[string]interface{}, 0, len(unmounted))
+	for _, server := range unmounted {
+		unmountedServers = append(unmountedServers, map[string]interface{}{
+			"instance_id": server.InstanceId,
+			"private_ip":  server.PrivateIp,
+		})
+	}
+
+	if err := d.Set("backend_servers", servers); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_backend_health", action, BCESDKGoERROR)
+	}
+	if err := d.Set("unmounted_servers", unmountedServers); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_backend_health", action, BCESDKGoERROR)
+	}
+	d.Set("all_healthy", allHealthy)
+	d.SetId(resource.UniqueId())
+
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		if err := writeToFile(v.(string), servers); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_backend_health", action, BCESDKGoERROR)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccAppBLBBackendHealthDataSourceName          = "data.baiducloud_appblb_backend_health.default"
+	testAccAppBLBBackendHealthDataSourceAttrKeyPrefix = "backend_servers.0."
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudAppBLBBackendHealthDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBLBBackendHealthDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccAppBLBBackendHealthDataSourceName),
+					resource.TestCheckResourceAttr(testAccAppBLBBackendHealthDataSourceName, "backend_servers.#", "1"),
+					resource.TestCheckResourceAttr(testAccAppBLBBackendHealthDataSourceName, testAccAppBLBBackendHealthDataSourceAttrKeyPrefix+"weight", "50"),
+					resource.TestCheckResourceAttrSet(testAccAppBLBBackendHealthDataSourceName, testAccAppBLBBackendHealthDataSourceAttrKeyPrefix+"instance_id"),
+					resource.TestCheckResourceAttrSet(testAccAppBLBBackendHealthDataSourceName, testAccAppBLBBackendHealthDataSourceAttrKeyPrefix+"healthy"),
+					resource.TestCheckResourceAttrSet(testAccAppBLBBackendHealthDataSourceName, testAccAppBLBBackendHealthDataSourceAttrKeyPrefix+"port_list.0.status"),
+					resource.TestCheckResourceAttrSet(testAccAppBLBBackendHealthDataSourceName, "all_healthy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAppBLBBackendHealthDataSourceConfig() string {
+	return testAccAppBLBServerGroupConfig() + fmt.Sprintf(`
+resource "baiducloud_appblb_server_group_member" "default" {
+  blb_id          = baiducloud_appblb.default.id
+  server_group_id = %s.%s.id
+  instance_id     = baiducloud_instance.default.id
+  weight          = 50
+}
+
+data "baiducloud_appblb_backend_health" "default" {
+  blb_id          = baiducloud_appblb.default.id
+  server_group_id = baiducloud_appblb_server_group_member.default.server_group_id
+}
+`, testAccAppBLBServerGroupResourceType, BaiduCloudTestResourceName)
+}
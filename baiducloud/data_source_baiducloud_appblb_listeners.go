@@ -199,6 +199,16 @@ func dataSourceBaiduCloudAppBLBListeners() *schema.Resource {
 										Description: "Policy bind server group name",
 										Computed:    true,
 									},
+									"app_ip_group_id": {
+										Type:        schema.TypeString,
+										Description: "Policy bind ip group ID",
+										Computed:    true,
+									},
+									"app_ip_group_name": {
+										Type:        schema.TypeString,
+										Description: "Policy bind ip group name",
+										Computed:    true,
+									},
 									"frontend_port": {
 										Type:        schema.TypeInt,
 										Description: "Frontend port",
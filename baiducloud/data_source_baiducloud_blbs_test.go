@@ -0,0 +1,53 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccBLBsDataSourceName          = "data.baiducloud_blbs.default"
+	testAccBLBsDataSourceAttrKeyPrefix = "blbs.0."
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBLBsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBLBsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBLBsDataSourceName),
+					resource.TestCheckResourceAttrSet(testAccBLBsDataSourceName, testAccBLBsDataSourceAttrKeyPrefix+"blb_id"),
+					resource.TestCheckResourceAttrSet(testAccBLBsDataSourceName, testAccBLBsDataSourceAttrKeyPrefix+"vpc_id"),
+					resource.TestCheckResourceAttrSet(testAccBLBsDataSourceName, testAccBLBsDataSourceAttrKeyPrefix+"subnet_id"),
+					resource.TestCheckResourceAttr(testAccBLBsDataSourceName, testAccBLBsDataSourceAttrKeyPrefix+"vpc_name", BaiduCloudTestResourceAttrNamePrefix+"VPC"),
+					resource.TestCheckResourceAttr(testAccBLBsDataSourceName, testAccBLBsDataSourceAttrKeyPrefix+"tags.testKey", "testValue"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBLBsDataSourceConfig() string {
+	return testAccBLBConfig(testAccBLBResourceAttrName, "") + fmt.Sprintf(`
+data "baiducloud_blbs" "default" {
+  blb_id  = %s.%s.id
+  name    = %s.%s.name
+  address = %s.%s.address
+
+  filter {
+    name = "vpc_id"
+    values = [baiducloud_vpc.default.id]
+  }
+}
+`, testAccBLBResourceType, BaiduCloudTestResourceName,
+		testAccBLBResourceType, BaiduCloudTestResourceName,
+		testAccBLBResourceType, BaiduCloudTestResourceName)
+}
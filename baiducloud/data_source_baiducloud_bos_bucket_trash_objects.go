@@ -0,0 +1,144 @@
+/*
+Use this data source to query the objects in the trash directory of a BOS bucket, which are deleted and can be recovered.
+
+Example Usage
+
+```hcl
+data "baiducloud_bos_bucket_trash_objects" "default" {
+  bucket = "my-bucket"
+}
+
+output "trash_objects" {
+  value = "${data.baiducloud_bos_bucket_trash_objects.default.objects}"
+}
+```
+*/
+package baiducloud
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudBosBucketTrashObjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudBosBucketTrashObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Description: "Bucket name of the trash objects to retrieve.",
+				Required:    true,
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Description: "Prefix of the original keys of the objects.",
+				Optional:    true,
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Output file for saving result.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"filter": dataSourceFiltersSchema(),
+
+			// Attributes used for result
+			"trash_dir": {
+				Type:        schema.TypeString,
+				Description: "Trash directory of the bucket, empty if the trash is not enabled.",
+				Computed:    true,
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Description: "List of the objects in the trash directory.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Description: "Key of the object in the trash directory.",
+							Computed:    true,
+						},
+						"original_key": {
+							Type:        schema.TypeString,
+							Description: "Key of the object before it was deleted.",
+							Computed:    true,
+						},
+						"last_modified": {
+							Type:        schema.TypeString,
+							Description: "Last modified time of the object.",
+							Computed:    true,
+						},
+						"etag": {
+							Type:        schema.TypeString,
+							Description: "Etag of the object.",
+							Computed:    true,
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Description: "Size of the object.",
+							Computed:    true,
+						},
+						"storage_class": {
+							Type:        schema.TypeString,
+							Description: "Storage class of the object.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudBosBucketTrashObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	bosService := BosService{client}
+
+	bucket := d.Get("bucket").(string)
+	action := "Query bucket " + bucket + " trash objects"
+
+	trashDir, err := bosService.GetBucketTrashDir(bucket)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_trash_objects", action, BCESDKGoERROR)
+	}
+
+	objectsResult := make([]map[string]interface{}, 0)
+	if trashDir != "" {
+		trashPrefix := strings.TrimSuffix(trashDir, "/") + "/"
+		objects, err := bosService.ListAllObjects(bucket, trashPrefix+d.Get("prefix").(string))
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_trash_objects", action, BCESDKGoERROR)
+		}
+
+		for _, obj := range objects {
+			objectsResult = append(objectsResult, map[string]interface{}{
+				"key":           obj.Key,
+				"original_key":  strings.TrimPrefix(obj.Key, trashPrefix),
+				"last_modified": obj.LastModified,
+				"etag":          obj.ETag,
+				"size":          obj.Size,
+				"storage_class": obj.StorageClass,
+			})
+		}
+	}
+
+	FilterDataSourceResult(d, &objectsResult)
+
+	d.Set("trash_dir", trashDir)
+	d.Set("objects", objectsResult)
+	d.SetId(resource.UniqueId())
+
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		if err := writeToFile(v.(string), objectsResult); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_trash_objects", action, BCESDKGoERROR)
+		}
+	}
+
+	return nil
+}
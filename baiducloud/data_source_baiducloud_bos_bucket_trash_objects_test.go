@@ -0,0 +1,73 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccBosBucketTrashObjectsDataSourceName          = "data.baiducloud_bos_bucket_trash_objects.default"
+	testAccBosBucketTrashObjectsDataSourceAttrKeyPrefix = "objects.0."
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBosBucketTrashObjectsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBosBucketTrashObjectsDataSourcePrepareConfig(true),
+			},
+			{
+				Config: testAccBosBucketTrashObjectsDataSourcePrepareConfig(false),
+			},
+			{
+				Config: testAccBosBucketTrashObjectsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosBucketTrashObjectsDataSourceName),
+					resource.TestCheckResourceAttr(testAccBosBucketTrashObjectsDataSourceName, "trash_dir", ".trash"),
+					resource.TestCheckResourceAttr(testAccBosBucketTrashObjectsDataSourceName, "objects.#", "1"),
+					resource.TestCheckResourceAttr(testAccBosBucketTrashObjectsDataSourceName, testAccBosBucketTrashObjectsDataSourceAttrKeyPrefix+"key", ".trash/"+testAccBosBucketObjectResourceAttrName),
+					resource.TestCheckResourceAttr(testAccBosBucketTrashObjectsDataSourceName, testAccBosBucketTrashObjectsDataSourceAttrKeyPrefix+"original_key", testAccBosBucketObjectResourceAttrName),
+					resource.TestCheckResourceAttrSet(testAccBosBucketTrashObjectsDataSourceName, testAccBosBucketTrashObjectsDataSourceAttrKeyPrefix+"etag"),
+					resource.TestCheckResourceAttrSet(testAccBosBucketTrashObjectsDataSourceName, testAccBosBucketTrashObjectsDataSourceAttrKeyPrefix+"size"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBosBucketTrashObjectsDataSourcePrepareConfig(withObject bool) string {
+	object := ""
+	if withObject {
+		object = fmt.Sprintf(`
+resource "baiducloud_bos_bucket_object" "default" {
+  bucket  = baiducloud_bos_bucket.default.bucket
+  key     = "%s"
+  content = "hello world"
+}
+`, testAccBosBucketObjectResourceAttrName)
+	}
+
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket        = "%s"
+  force_destroy = true
+
+  trash {}
+}
+%s`, testAccBosBucketResourceAttrName, object)
+}
+
+func testAccBosBucketTrashObjectsDataSourceConfig() string {
+	return testAccBosBucketTrashObjectsDataSourcePrepareConfig(false) + `
+data "baiducloud_bos_bucket_trash_objects" "default" {
+  bucket = baiducloud_bos_bucket.default.bucket
+}
+`
+}
@@ -251,6 +251,11 @@ func dataSourceBaiduCloudBosBuckets() *schema.Resource {
 							Description: "Encryption of the bucket.",
 							Computed:    true,
 						},
+						"kms_key_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the KMS key used to encrypt the objects by default.",
+							Computed:    true,
+						},
 						"website": {
 							Type:        schema.TypeList,
 							Description: "Website of the BOS bucket.",
@@ -329,6 +334,21 @@ func dataSourceBaiduCloudBosBuckets() *schema.Resource {
 								},
 							},
 						},
+
+						"trash": {
+							Type:        schema.TypeList,
+							Description: "Configuration of the trash.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"trash_dir": {
+										Type:        schema.TypeString,
+										Description: "Name of the trash directory.",
+										Computed:    true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -404,7 +424,7 @@ func dataSourceBaiduCloudBosBucketsReadBucketData(meta interface{}, bucket strin
 	bucMap := make(map[string]interface{})
 
 	// read bucket acl
-	acl, err := bosService.resourceBaiduCloudBosBucketReadAcl(bucket)
+	acl, _, err := bosService.resourceBaiduCloudBosBucketReadAcl(bucket)
 	if err != nil {
 		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_buckets", action, BCESDKGoERROR)
 	}
@@ -441,13 +461,12 @@ func dataSourceBaiduCloudBosBucketsReadBucketData(meta interface{}, bucket strin
 	bucMap["storage_class"] = raw.(string)
 
 	// read server_side_encryption_rule
-	raw, err = client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
-		return bosClient.GetBucketEncryption(bucket)
-	})
+	encryption, err := bosService.GetBucketEncryption(bucket)
 	if err != nil {
 		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_buckets", action, BCESDKGoERROR)
 	}
-	bucMap["server_side_encryption_rule"] = raw.(string)
+	bucMap["server_side_encryption_rule"] = encryption.EncryptionAlgorithm
+	bucMap["kms_key_id"] = encryption.KmsKeyId
 
 	// read website
 	website, err := bosService.resourceBaiduCloudBosBucketReadWebsite(bucket)
@@ -470,5 +489,12 @@ func dataSourceBaiduCloudBosBucketsReadBucketData(meta interface{}, bucket strin
 	}
 	bucMap["copyright_protection"] = copyright
 
+	// read trash
+	trash, err := bosService.resourceBaiduCloudBosBucketReadTrash(bucket)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_buckets", action, BCESDKGoERROR)
+	}
+	bucMap["trash"] = trash
+
 	return bucMap, nil
 }
@@ -0,0 +1,218 @@
+/*
+Use this data source to read the content and metadata of a BOS object, such as a config file stored in BOS. The content
+is only read for the objects not larger than max_body_size_in_kb and must be UTF-8 text. The objects encrypted by the
+client_side_encryption of baiducloud_bos_bucket_object are decrypted with the same key.
+
+Example Usage
+
+```hcl
+data "baiducloud_bos_object" "default" {
+  bucket = "my-bucket"
+  key    = "configs/app.json"
+}
+
+data "baiducloud_bos_object" "secret" {
+  bucket = "my-bucket"
+  key    = "configs/secret.json"
+
+  client_side_encryption {
+    key = "${var.client_side_key}"
+  }
+}
+
+output "config" {
+  value = "${jsondecode(data.baiducloud_bos_object.default.body)}"
+}
+```
+*/
+package baiducloud
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/baidubce/bce-sdk-go/services/bos"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudBosObject() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudBosObjectRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Description: "Name of the bucket.",
+				Required:    true,
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Description: "Key of the object.",
+				Required:    true,
+			},
+			"max_body_size_in_kb": {
+				Type:         schema.TypeInt,
+				Description:  "Max size(KB) of the object content to read, support between 1 and 10240. Default to 1024.",
+				Optional:     true,
+				Default:      1024,
+				ValidateFunc: validation.IntBetween(1, 10240),
+			},
+			"client_side_encryption": {
+				Type:        schema.TypeList,
+				Description: "Configuration of the client side encryption used to decrypt the object encrypted by baiducloud_bos_bucket_object. The max_body_size_in_kb is checked against the encrypted content.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Description:  "Base64 encoded AES key used to encrypt the data key, which must be 16, 24 or 32 bytes.",
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validateBOSClientSideEncryptionKey,
+						},
+					},
+				},
+			},
+
+			// Attributes used for result
+			"body": {
+				Type:        schema.TypeString,
+				Description: "Content of the object.",
+				Computed:    true,
+			},
+			"cache_control": {
+				Type:        schema.TypeString,
+				Description: "Caching behavior of the object.",
+				Computed:    true,
+			},
+			"content_disposition": {
+				Type:        schema.TypeString,
+				Description: "Content disposition of the object.",
+				Computed:    true,
+			},
+			"content_md5": {
+				Type:        schema.TypeString,
+				Description: "MD5 value of the object content defined in RFC2616.",
+				Computed:    true,
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Description: "Content type of the object data.",
+				Computed:    true,
+			},
+			"content_length": {
+				Type:        schema.TypeInt,
+				Description: "Content length of the object.",
+				Computed:    true,
+			},
+			"expires": {
+				Type:        schema.TypeString,
+				Description: "Expire date of the object.",
+				Computed:    true,
+			},
+			"user_meta": {
+				Type:        schema.TypeMap,
+				Description: "Metadata of the object.",
+				Computed:    true,
+			},
+			"content_sha256": {
+				Type:        schema.TypeString,
+				Description: "Sha256 value of the object.",
+				Computed:    true,
+			},
+			"content_crc32": {
+				Type:        schema.TypeString,
+				Description: "Crc(cyclic redundancy check code) value of the object.",
+				Computed:    true,
+			},
+			"content_encoding": {
+				Type:        schema.TypeString,
+				Description: "Encoding of the object.",
+				Computed:    true,
+			},
+			"last_modified": {
+				Type:        schema.TypeString,
+				Description: "Last modified time of the object.",
+				Computed:    true,
+			},
+			"etag": {
+				Type:        schema.TypeString,
+				Description: "Etag of the object.",
+				Computed:    true,
+			},
+			"storage_class": {
+				Type:        schema.TypeString,
+				Description: "Storage class of the object.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudBosObjectRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	action := "Query bucket " + bucket + " object " + key
+
+	// check the size with the meta first so that the large objects are not downloaded
+	objMap, err := dataSourceBaiduCloudBosBucketObjectsReadMeta(bucket, key, meta)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_object", action, BCESDKGoERROR)
+	}
+	limit := int64(d.Get("max_body_size_in_kb").(int)) << 10
+	if objMap["content_length"].(int64) > limit {
+		return WrapError(fmt.Errorf("content length %d of bucket %s object %s exceeds max_body_size_in_kb %d",
+			objMap["content_length"].(int64), bucket, key, d.Get("max_body_size_in_kb").(int)))
+	}
+
+	var userMeta map[string]string
+	raw, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+		result, err := bosClient.BasicGetObject(bucket, key)
+		if err != nil {
+			return nil, err
+		}
+		defer result.Body.Close()
+		userMeta = result.UserMeta
+
+		// the object may be overwritten after reading the meta, read no more than the limit anyway
+		return ioutil.ReadAll(io.LimitReader(result.Body, limit+1))
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_object", action, BCESDKGoERROR)
+	}
+	body := raw.([]byte)
+	if int64(len(body)) > limit {
+		return WrapError(fmt.Errorf("content of bucket %s object %s exceeds max_body_size_in_kb %d",
+			bucket, key, d.Get("max_body_size_in_kb").(int)))
+	}
+	if v, ok := d.GetOk("client_side_encryption"); ok {
+		masterKey, _ := base64.StdEncoding.DecodeString(v.([]interface{})[0].(map[string]interface{})["key"].(string))
+		decrypted := &bytes.Buffer{}
+		if err := decryptBucketObjectContent(masterKey, userMeta, bytes.NewReader(body), decrypted); err != nil {
+			return WrapError(fmt.Errorf("decrypt bucket %s object %s: %v", bucket, key, err))
+		}
+		body = decrypted.Bytes()
+	}
+	if !utf8.Valid(body) {
+		return WrapError(fmt.Errorf("content of bucket %s object %s is not UTF-8 text", bucket, key))
+	}
+
+	d.SetId(strings.Join([]string{bucket, key}, COLON_SEPARATED))
+	d.Set("body", string(body))
+	for k, v := range objMap {
+		d.Set(k, v)
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package baiducloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccBosObjectDataSourceName = "data.baiducloud_bos_object.default"
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBosObjectDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBosObjectDataSourceConfig(1024),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosObjectDataSourceName),
+					resource.TestCheckResourceAttr(testAccBosObjectDataSourceName, "body", `{"name": "hello"}`),
+					resource.TestCheckResourceAttr(testAccBosObjectDataSourceName, "content_type", "application/json"),
+					resource.TestCheckResourceAttr(testAccBosObjectDataSourceName, "content_length", "17"),
+					resource.TestCheckResourceAttrSet(testAccBosObjectDataSourceName, "etag"),
+					resource.TestCheckResourceAttrSet(testAccBosObjectDataSourceName, "last_modified"),
+				),
+			},
+		},
+	})
+}
+
+//lintignore:AT003
+func TestAccBaiduCloudBosObjectDataSource_ClientSideEncryption(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBosObjectDataSourceClientSideEncryptionConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosObjectDataSourceName),
+					resource.TestCheckResourceAttr(testAccBosObjectDataSourceName, "body", "hello world"),
+					resource.TestCheckResourceAttr(testAccBosObjectDataSourceName, "user_meta.client-side-encryption-algorithm", BOS_CLIENT_SIDE_ENCRYPTION_ALGORITHM),
+				),
+			},
+		},
+	})
+}
+
+//lintignore:AT003
+func TestAccBaiduCloudBosObjectDataSource_TooLarge(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBosObjectDataSourceLargeConfig(),
+				ExpectError: regexp.MustCompile("exceeds max_body_size_in_kb"),
+			},
+		},
+	})
+}
+
+func testAccBosObjectDataSourceConfig(maxBodySize int) string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%s"
+}
+
+resource "baiducloud_bos_bucket_object" "default" {
+  bucket       = baiducloud_bos_bucket.default.bucket
+  key          = "%s"
+  content      = "{\"name\": \"hello\"}"
+  content_type = "application/json"
+}
+
+data "baiducloud_bos_object" "default" {
+  bucket              = baiducloud_bos_bucket_object.default.bucket
+  key                 = baiducloud_bos_bucket_object.default.key
+  max_body_size_in_kb = %d
+}
+`, testAccBosBucketResourceAttrName, testAccBosBucketObjectResourceAttrName, maxBodySize)
+}
+
+func testAccBosObjectDataSourceLargeConfig() string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%s"
+}
+
+resource "baiducloud_bos_bucket_object" "default" {
+  bucket  = baiducloud_bos_bucket.default.bucket
+  key     = "%s"
+  content = join("", [for i in range(2048) : "x"])
+}
+
+data "baiducloud_bos_object" "default" {
+  bucket              = baiducloud_bos_bucket_object.default.bucket
+  key                 = baiducloud_bos_bucket_object.default.key
+  max_body_size_in_kb = 1
+}
+`, testAccBosBucketResourceAttrName, testAccBosBucketObjectResourceAttrName)
+}
+
+func testAccBosObjectDataSourceClientSideEncryptionConfig() string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%s"
+}
+
+resource "baiducloud_bos_bucket_object" "default" {
+  bucket  = baiducloud_bos_bucket.default.bucket
+  key     = "%s"
+  content = "hello world"
+
+  client_side_encryption {
+    key = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+  }
+}
+
+data "baiducloud_bos_object" "default" {
+  bucket = baiducloud_bos_bucket_object.default.bucket
+  key    = baiducloud_bos_bucket_object.default.key
+
+  client_side_encryption {
+    key = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+  }
+}
+`, testAccBosBucketResourceAttrName, testAccBosBucketObjectResourceAttrName)
+}
@@ -0,0 +1,125 @@
+/*
+Use this data source to generate a presigned url of a BOS object, which can be used to access the object without
+credentials before it expires.
+
+Example Usage
+
+```hcl
+data "baiducloud_bos_presigned_url" "default" {
+  bucket                = "my-bucket"
+  key                   = "releases/app.tar.gz"
+  expiration_in_seconds = 3600
+}
+
+output "download_url" {
+  value = "${data.baiducloud_bos_presigned_url.default.url}"
+}
+```
+*/
+package baiducloud
+
+import (
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/services/bos"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudBosPresignedUrl() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudBosPresignedUrlRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Description: "Name of the bucket.",
+				Required:    true,
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Description: "Key of the object.",
+				Required:    true,
+			},
+			"expiration_in_seconds": {
+				Type:         schema.TypeInt,
+				Description:  "Seconds the url is valid for, support between 1 and 604800. Default to 1800.",
+				Optional:     true,
+				Default:      1800,
+				ValidateFunc: validation.IntBetween(1, 604800),
+			},
+			"method": {
+				Type:         schema.TypeString,
+				Description:  "HTTP method the url is signed for, valid values are GET, PUT, HEAD, DELETE and POST. Default to GET.",
+				Optional:     true,
+				Default:      "GET",
+				ValidateFunc: validation.StringInSlice([]string{"GET", "PUT", "HEAD", "DELETE", "POST"}, false),
+			},
+			"headers": {
+				Type:        schema.TypeMap,
+				Description: "Headers to be signed, the request with the url must carry the same headers.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"params": {
+				Type:        schema.TypeMap,
+				Description: "Query parameters to be signed and added to the url.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			// Attributes used for result
+			"url": {
+				Type:        schema.TypeString,
+				Description: "Presigned url of the object.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expiration": {
+				Type:        schema.TypeString,
+				Description: "Expiration time of the url in RFC3339 format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudBosPresignedUrlRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	expiration := d.Get("expiration_in_seconds").(int)
+	method := d.Get("method").(string)
+	action := "Generate bucket " + bucket + " object " + key + " presigned url"
+
+	headers := make(map[string]string)
+	for k, v := range d.Get("headers").(map[string]interface{}) {
+		headers[k] = v.(string)
+	}
+	params := make(map[string]string)
+	for k, v := range d.Get("params").(map[string]interface{}) {
+		params[k] = v.(string)
+	}
+
+	now := time.Now()
+	raw, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+		return bosClient.GeneratePresignedUrl(bucket, key, expiration, method, headers, params), nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_presigned_url", action, BCESDKGoERROR)
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("url", raw.(string))
+	d.Set("expiration", now.Add(time.Duration(expiration)*time.Second).UTC().Format(time.RFC3339))
+
+	return nil
+}
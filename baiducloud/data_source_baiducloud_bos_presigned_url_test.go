@@ -0,0 +1,44 @@
+package baiducloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccBosPresignedUrlDataSourceName = "data.baiducloud_bos_presigned_url.default"
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBosPresignedUrlDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBosPresignedUrlDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosPresignedUrlDataSourceName),
+					resource.TestMatchResourceAttr(testAccBosPresignedUrlDataSourceName, "url", regexp.MustCompile("authorization=bce-auth-v1")),
+					resource.TestCheckResourceAttrSet(testAccBosPresignedUrlDataSourceName, "expiration"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBosPresignedUrlDataSourceConfig() string {
+	return fmt.Sprintf(`
+data "baiducloud_bos_presigned_url" "default" {
+  bucket                = "%s"
+  key                   = "%s"
+  expiration_in_seconds = 600
+  method                = "GET"
+}
+`, testAccBosBucketResourceAttrName, testAccBosBucketObjectResourceAttrName)
+}
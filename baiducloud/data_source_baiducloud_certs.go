@@ -5,7 +5,11 @@ Example Usage
 
 ```hcl
 data "baiducloud_certs" "default" {
-  name = "testCert"
+  cert_name = "testCert"
+}
+
+data "baiducloud_certs" "expiring" {
+  expire_in_days = 30
 }
 
 output "certs" {
@@ -16,9 +20,13 @@ output "certs" {
 package baiducloud
 
 import (
+	"time"
+
 	"github.com/baidubce/bce-sdk-go/services/cert"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
 
@@ -33,6 +41,13 @@ func dataSourceBaiduCloudCerts() *schema.Resource {
 				Optional:    true,
 				ForceNew:    true,
 			},
+			"expire_in_days": {
+				Type:         schema.TypeInt,
+				Description:  "Only query the Certs which expire within the given days, including the expired ones",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"output_file": {
 				Type:        schema.TypeString,
 				Description: "Certs search result output file",
@@ -111,11 +126,22 @@ func dataSourceBaiduCloudCertsRead(d *schema.ResourceData, meta interface{}) err
 	if v, ok := d.GetOk("cert_name"); ok && v.(string) != "" {
 		certName = v.(string)
 	}
+	var expireBefore *time.Time
+	if v, ok := d.GetOkExists("expire_in_days"); ok {
+		t := time.Now().AddDate(0, 0, v.(int))
+		expireBefore = &t
+	}
 	certList := make([]map[string]interface{}, 0, len(response.Certs))
 	for _, c := range response.Certs {
 		if certName != "" && certName != c.CertName {
 			continue
 		}
+		if expireBefore != nil {
+			stopTime, err := time.Parse(time.RFC3339, c.CertStopTime)
+			if err != nil || stopTime.After(*expireBefore) {
+				continue
+			}
+		}
 
 		certList = append(certList, map[string]interface{}{
 			"cert_id":          c.CertId,
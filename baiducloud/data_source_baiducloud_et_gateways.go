@@ -0,0 +1,177 @@
+/*
+Use this data source to query ET gateway list.
+
+Example Usage
+
+```hcl
+data "baiducloud_et_gateways" "default" {
+ vpc_id = "vpc-y4p102r3mz6m"
+}
+
+output "et_gateways" {
+ value = "${data.baiducloud_et_gateways.default.et_gateways}"
+}
+```
+*/
+package baiducloud
+
+import (
+	"github.com/baidubce/bce-sdk-go/services/etGateway"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudEtGateways() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudEtGatewaysRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Description: "VPC ID where the ET gateways located.",
+				Required:    true,
+			},
+			"et_gateway_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the ET gateway to retrieve.",
+				Optional:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the ET gateway.",
+				Optional:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Status of the ET gateway.",
+				Optional:    true,
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Output file for saving result.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"filter": dataSourceFiltersSchema(),
+
+			// Attributes used for result
+			"et_gateways": {
+				Type:        schema.TypeList,
+				Description: "The list of ET gateways.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"et_gateway_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the ET gateway.",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the ET gateway.",
+							Computed:    true,
+						},
+						"vpc_id": {
+							Type:        schema.TypeString,
+							Description: "VPC ID of the ET gateway.",
+							Computed:    true,
+						},
+						"speed": {
+							Type:        schema.TypeInt,
+							Description: "Bandwidth of the ET gateway in Mbps.",
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "Description of the ET gateway.",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Status of the ET gateway.",
+							Computed:    true,
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Description: "Create time of the ET gateway.",
+							Computed:    true,
+						},
+						"et_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the ET dedicated line bound with the ET gateway.",
+							Computed:    true,
+						},
+						"channel_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the ET channel bound with the ET gateway.",
+							Computed:    true,
+						},
+						"local_cidrs": {
+							Type:        schema.TypeList,
+							Description: "Local CIDRs of the ET gateway.",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudEtGatewaysRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	etService := EtService{client}
+
+	args := &etGateway.ListEtGatewayArgs{
+		VpcId: d.Get("vpc_id").(string),
+	}
+	if v, ok := d.GetOk("et_gateway_id"); ok {
+		args.EtGatewayId = v.(string)
+	}
+	if v, ok := d.GetOk("name"); ok {
+		args.Name = v.(string)
+	}
+	if v, ok := d.GetOk("status"); ok {
+		args.Status = v.(string)
+	}
+	action := "Query ET Gateways " + args.VpcId + "_" + args.EtGatewayId + "_" + args.Name
+
+	gateways, err := etService.ListAllEtGateways(args)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_gateways", action, BCESDKGoERROR)
+	}
+
+	gatewaysResult := make([]map[string]interface{}, 0, len(gateways))
+	for _, gateway := range gateways {
+		gatewaysResult = append(gatewaysResult, map[string]interface{}{
+			"et_gateway_id": gateway.EtGatewayId,
+			"name":          gateway.Name,
+			"vpc_id":        gateway.VpcId,
+			"speed":         gateway.Speed,
+			"description":   gateway.Description,
+			"status":        gateway.Status,
+			"create_time":   gateway.CreateTime,
+			"et_id":         gateway.EtId,
+			"channel_id":    gateway.ChannelId,
+			"local_cidrs":   gateway.LocalCidrs,
+		})
+	}
+
+	FilterDataSourceResult(d, &gatewaysResult)
+	d.Set("et_gateways", gatewaysResult)
+
+	d.SetId(resource.UniqueId())
+
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		if err := writeToFile(v.(string), gatewaysResult); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_gateways", action, BCESDKGoERROR)
+		}
+	}
+
+	return nil
+}
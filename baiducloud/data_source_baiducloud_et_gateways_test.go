@@ -0,0 +1,61 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccEtGatewaysDataSourceName          = "data.baiducloud_et_gateways.default"
+	testAccEtGatewaysDataSourceAttrKeyPrefix = "et_gateways.0."
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudEtGatewaysDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEtGatewaysDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccEtGatewaysDataSourceName),
+					resource.TestCheckResourceAttr(testAccEtGatewaysDataSourceName, "et_gateways.#", "1"),
+					resource.TestCheckResourceAttr(testAccEtGatewaysDataSourceName, testAccEtGatewaysDataSourceAttrKeyPrefix+"name", testAccEtGatewayResourceAttrName),
+					resource.TestCheckResourceAttr(testAccEtGatewaysDataSourceName, testAccEtGatewaysDataSourceAttrKeyPrefix+"speed", "100"),
+					resource.TestCheckResourceAttrSet(testAccEtGatewaysDataSourceName, testAccEtGatewaysDataSourceAttrKeyPrefix+"et_gateway_id"),
+					resource.TestCheckResourceAttrSet(testAccEtGatewaysDataSourceName, testAccEtGatewaysDataSourceAttrKeyPrefix+"status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEtGatewaysDataSourceConfig() string {
+	return fmt.Sprintf(`
+resource "baiducloud_vpc" "default" {
+  name = "%s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "baiducloud_et_gateway" "default" {
+  name   = "%s"
+  vpc_id = baiducloud_vpc.default.id
+  speed  = 100
+}
+
+data "baiducloud_et_gateways" "default" {
+  vpc_id = baiducloud_et_gateway.default.vpc_id
+
+  filter {
+    name = "name"
+    values = ["test-BaiduAcc*"]
+  }
+}
+`, BaiduCloudTestResourceAttrNamePrefix+"VPC", testAccEtGatewayResourceAttrName)
+}
@@ -0,0 +1,195 @@
+/*
+Use this data source to query the IP usage of a subnet.
+
+Example Usage
+
+```hcl
+data "baiducloud_subnet_ip_usage" "default" {
+  subnet_id        = "sbn-idf0gqbpqyqc"
+  private_ip_range = "192.168.1.2-192.168.1.10"
+}
+
+output "available_ip_count" {
+  value = "${data.baiducloud_subnet_ip_usage.default.available_ip_count}"
+}
+```
+*/
+package baiducloud
+
+import (
+	"net"
+
+	"github.com/baidubce/bce-sdk-go/services/vpc"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudSubnetIpUsage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudSubnetIpUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the subnet to retrieve.",
+				Required:    true,
+			},
+			"private_ip_range": {
+				Type:          schema.TypeString,
+				Description:   "Range of the private IPs to look up, such as 192.168.1.2-192.168.1.10. No more than 100 IPs are allowed.",
+				Optional:      true,
+				ConflictsWith: []string{"private_ip_addresses"},
+			},
+			"private_ip_addresses": {
+				Type:          schema.TypeList,
+				Description:   "Private IPs to look up. No more than 100 IPs are allowed.",
+				Optional:      true,
+				MaxItems:      100,
+				ConflictsWith: []string{"private_ip_range"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.SingleIP(),
+				},
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Output file for saving result.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+
+			// Attributes used for result
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the VPC which the subnet belongs to.",
+				Computed:    true,
+			},
+			"cidr": {
+				Type:        schema.TypeString,
+				Description: "CIDR block of the subnet.",
+				Computed:    true,
+			},
+			"total_ip_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of IP addresses in the CIDR block of the subnet.",
+				Computed:    true,
+			},
+			"available_ip_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of available IP addresses in the subnet.",
+				Computed:    true,
+			},
+			"used_ip_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of IP addresses which are not available in the subnet, including the addresses reserved by the system.",
+				Computed:    true,
+			},
+			"private_ips": {
+				Type:        schema.TypeList,
+				Description: "Usage of the private IPs looked up by private_ip_range or private_ip_addresses. Only IPs in use and within the subnet are returned.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"private_ip_address": {
+							Type:        schema.TypeString,
+							Description: "Private IP address.",
+							Computed:    true,
+						},
+						"cidr": {
+							Type:        schema.TypeString,
+							Description: "CIDR block of the subnet which the private IP belongs to.",
+							Computed:    true,
+						},
+						"private_ip_address_type": {
+							Type:        schema.TypeString,
+							Description: "Type of the private IP address.",
+							Computed:    true,
+						},
+						"created_time": {
+							Type:        schema.TypeString,
+							Description: "Created time of the private IP address.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudSubnetIpUsageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	vpcService := &VpcService{client}
+
+	subnetID := d.Get("subnet_id").(string)
+	action := "Query Subnet IP usage " + subnetID
+
+	subnet, err := vpcService.GetSubnetDetail(subnetID)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_subnet_ip_usage", action, BCESDKGoERROR)
+	}
+	addDebug(action, subnet)
+
+	_, subnetNet, err := net.ParseCIDR(subnet.Subnet.Cidr)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_subnet_ip_usage", action, BCESDKGoERROR)
+	}
+	ones, bits := subnetNet.Mask.Size()
+	totalIpCount := 1 << uint(bits-ones)
+
+	privateIpsResult := make([]map[string]interface{}, 0)
+	args := &vpc.GetVpcPrivateIpArgs{
+		VpcId: subnet.Subnet.VPCId,
+	}
+	if v, ok := d.GetOk("private_ip_range"); ok {
+		args.PrivateIpRange = v.(string)
+	}
+	if v, ok := d.GetOk("private_ip_addresses"); ok {
+		args.PrivateIpAddresses = expandStringList(v.([]interface{}))
+	}
+	if args.PrivateIpRange != "" || len(args.PrivateIpAddresses) > 0 {
+		privateIps, err := vpcService.GetPrivateIpAddressesInfo(args)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_subnet_ip_usage", action, BCESDKGoERROR)
+		}
+
+		// the private ips are queried in the whole vpc, only keep the ones in the subnet
+		for _, ip := range privateIps {
+			if !subnetNet.Contains(net.ParseIP(ip.PrivateIpAddress)) {
+				continue
+			}
+			privateIpsResult = append(privateIpsResult, map[string]interface{}{
+				"private_ip_address":      ip.PrivateIpAddress,
+				"cidr":                    ip.Cidr,
+				"private_ip_address_type": ip.PrivateIpAddressType,
+				"created_time":            ip.CreatedTime,
+			})
+		}
+	}
+
+	d.SetId(subnetID)
+	d.Set("vpc_id", subnet.Subnet.VPCId)
+	d.Set("cidr", subnet.Subnet.Cidr)
+	d.Set("total_ip_count", totalIpCount)
+	d.Set("available_ip_count", subnet.Subnet.AvailableIp)
+	d.Set("used_ip_count", totalIpCount-subnet.Subnet.AvailableIp)
+	d.Set("private_ips", privateIpsResult)
+
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		result := map[string]interface{}{
+			"subnet_id":          subnetID,
+			"cidr":               subnet.Subnet.Cidr,
+			"total_ip_count":     totalIpCount,
+			"available_ip_count": subnet.Subnet.AvailableIp,
+			"used_ip_count":      totalIpCount - subnet.Subnet.AvailableIp,
+			"private_ips":        privateIpsResult,
+		}
+		if err := writeToFile(v.(string), result); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_subnet_ip_usage", action, BCESDKGoERROR)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package baiducloud
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const (
+	testAccSubnetIpUsageDataSourceName      = "data.baiducloud_subnet_ip_usage.default"
+	testAccSubnetIpUsageOtherDataSourceName = "data.baiducloud_subnet_ip_usage.other"
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudSubnetIpUsageDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubnetIpUsageDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccSubnetIpUsageDataSourceName),
+					resource.TestCheckResourceAttrSet(testAccSubnetIpUsageDataSourceName, "vpc_id"),
+					resource.TestCheckResourceAttr(testAccSubnetIpUsageDataSourceName, "cidr", "192.168.1.0/24"),
+					resource.TestCheckResourceAttr(testAccSubnetIpUsageDataSourceName, "total_ip_count", "256"),
+					resource.TestCheckResourceAttrSet(testAccSubnetIpUsageDataSourceName, "available_ip_count"),
+					resource.TestCheckResourceAttrSet(testAccSubnetIpUsageDataSourceName, "used_ip_count"),
+					resource.TestCheckResourceAttrSet(testAccSubnetIpUsageDataSourceName, "private_ips.#"),
+					testAccCheckSubnetIpUsagePrivateIpsInCidr(testAccSubnetIpUsageDataSourceName, "192.168.1.0/24"),
+					testAccCheckBaiduCloudDataSourceId(testAccSubnetIpUsageOtherDataSourceName),
+					resource.TestCheckResourceAttr(testAccSubnetIpUsageOtherDataSourceName, "cidr", "192.168.2.0/24"),
+					testAccCheckSubnetIpUsagePrivateIpsInCidr(testAccSubnetIpUsageOtherDataSourceName, "192.168.2.0/24"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckSubnetIpUsagePrivateIpsInCidr checks that the private ips of the other subnets in the vpc are dropped
+func testAccCheckSubnetIpUsagePrivateIpsInCidr(name, cidr string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("can't find subnet ip usage data source: %s", name)
+		}
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return err
+		}
+
+		count, _ := strconv.Atoi(rs.Primary.Attributes["private_ips.#"])
+		for i := 0; i < count; i++ {
+			ip := rs.Primary.Attributes[fmt.Sprintf("private_ips.%d.private_ip_address", i)]
+			if !ipNet.Contains(net.ParseIP(ip)) {
+				return fmt.Errorf("private ip %s of %s is out of the subnet cidr %s", ip, name, cidr)
+			}
+		}
+
+		return nil
+	}
+}
+
+const testAccSubnetIpUsageDataSourceConfig = `
+data "baiducloud_zones" "default" {}
+
+resource "baiducloud_vpc" "default" {
+  name        = "test-BaiduAccVPC"
+  description = "test baidu Acc"
+  cidr        = "192.168.0.0/16"
+}
+
+resource "baiducloud_subnet" "default" {
+  name        = "test-BaiduAccSubnet"
+  zone_name   = data.baiducloud_zones.default.zones.0.zone_name
+  cidr        = "192.168.1.0/24"
+  description = "created by terraform"
+  vpc_id      = baiducloud_vpc.default.id
+}
+
+resource "baiducloud_subnet" "other" {
+  name        = "test-BaiduAccSubnetOther"
+  zone_name   = data.baiducloud_zones.default.zones.0.zone_name
+  cidr        = "192.168.2.0/24"
+  description = "created by terraform"
+  vpc_id      = baiducloud_vpc.default.id
+}
+
+data "baiducloud_subnet_ip_usage" "default" {
+  subnet_id            = baiducloud_subnet.default.id
+  private_ip_addresses = ["192.168.1.2", "192.168.1.3", "192.168.2.2", "192.168.2.3"]
+}
+
+data "baiducloud_subnet_ip_usage" "other" {
+  subnet_id            = baiducloud_subnet.other.id
+  private_ip_addresses = ["192.168.1.2", "192.168.1.3", "192.168.2.2", "192.168.2.3"]
+}
+`
@@ -0,0 +1,243 @@
+/*
+Use this data source to query VPN conn list of a VPN gateway.
+
+Example Usage
+
+```hcl
+data "baiducloud_vpn_conns" "default" {
+ vpn_id = "vpn-shyt3wb0g0d4"
+}
+
+output "vpn_conns" {
+ value = "${data.baiducloud_vpn_conns.default.vpn_conns}"
+}
+```
+*/
+package baiducloud
+
+import (
+	"github.com/baidubce/bce-sdk-go/services/vpn"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudVpnConns() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudVpnConnsRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpn_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the VPN gateway the VPN conns belong to.",
+				Required:    true,
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Output file for saving result.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"filter": dataSourceFiltersSchema(),
+
+			// Attributes used for result
+			"vpn_conns": {
+				Type:        schema.TypeList,
+				Description: "The list of VPN conns.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpn_conn_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the VPN conn.",
+							Computed:    true,
+						},
+						"vpn_conn_name": {
+							Type:        schema.TypeString,
+							Description: "Name of the VPN conn.",
+							Computed:    true,
+						},
+						"local_ip": {
+							Type:        schema.TypeString,
+							Description: "Public IP of the VPN gateway.",
+							Computed:    true,
+						},
+						"local_subnets": {
+							Type:        schema.TypeList,
+							Description: "Local subnets of the VPN conn.",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"remote_ip": {
+							Type:        schema.TypeString,
+							Description: "Public IP of the remote (customer) gateway.",
+							Computed:    true,
+						},
+						"remote_subnets": {
+							Type:        schema.TypeList,
+							Description: "Subnets behind the remote (customer) gateway.",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "Description of the VPN conn.",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Status of the VPN conn.",
+							Computed:    true,
+						},
+						"health_status": {
+							Type:        schema.TypeString,
+							Description: "Health status of the VPN conn.",
+							Computed:    true,
+						},
+						"created_time": {
+							Type:        schema.TypeString,
+							Description: "Create time of the VPN conn.",
+							Computed:    true,
+						},
+						"ike_config": {
+							Type:        schema.TypeList,
+							Description: "IKE config of the VPN conn.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ike_version": {
+										Type:        schema.TypeString,
+										Description: "Version of the IKE protocol.",
+										Computed:    true,
+									},
+									"ike_mode": {
+										Type:        schema.TypeString,
+										Description: "Negotiation mode of the IKE.",
+										Computed:    true,
+									},
+									"ike_enc_alg": {
+										Type:        schema.TypeString,
+										Description: "Encryption algorithm of the IKE.",
+										Computed:    true,
+									},
+									"ike_auth_alg": {
+										Type:        schema.TypeString,
+										Description: "Authentication algorithm of the IKE.",
+										Computed:    true,
+									},
+									"ike_pfs": {
+										Type:        schema.TypeString,
+										Description: "Diffie-Hellman group of the IKE.",
+										Computed:    true,
+									},
+									"ike_lifetime": {
+										Type:        schema.TypeInt,
+										Description: "SA lifetime of the IKE in seconds.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"ipsec_config": {
+							Type:        schema.TypeList,
+							Description: "IPSec config of the VPN conn.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ipsec_enc_alg": {
+										Type:        schema.TypeString,
+										Description: "Encryption algorithm of the IPSec.",
+										Computed:    true,
+									},
+									"ipsec_auth_alg": {
+										Type:        schema.TypeString,
+										Description: "Authentication algorithm of the IPSec.",
+										Computed:    true,
+									},
+									"ipsec_pfs": {
+										Type:        schema.TypeString,
+										Description: "Perfect forward secrecy group of the IPSec.",
+										Computed:    true,
+									},
+									"ipsec_lifetime": {
+										Type:        schema.TypeInt,
+										Description: "SA lifetime of the IPSec in seconds.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudVpnConnsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	vpnID := d.Get("vpn_id").(string)
+	action := "Query VPN Conns of VPN Gateway " + vpnID
+
+	raw, err := client.WithVpnClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
+		return vpnClient.ListVpnConn(vpnID)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_conns", action, BCESDKGoERROR)
+	}
+
+	connsResult := make([]map[string]interface{}, 0)
+	for _, conn := range raw.(*vpn.ListVpnConnResult).VpnConns {
+		connMap, err := flattenVpnConn(&conn)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_conns", action, BCESDKGoERROR)
+		}
+		connsResult = append(connsResult, connMap)
+	}
+
+	FilterDataSourceResult(d, &connsResult)
+	d.Set("vpn_conns", connsResult)
+
+	d.SetId(resource.UniqueId())
+
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		if err := writeToFile(v.(string), connsResult); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_conns", action, BCESDKGoERROR)
+		}
+	}
+
+	return nil
+}
+
+func flattenVpnConn(conn *vpn.VpnConn) (map[string]interface{}, error) {
+	ikeConfig, err := flattenVpnConnIkeConfig(conn.IkeConfig)
+	if err != nil {
+		return nil, err
+	}
+	ipsecConfig, err := flattenVpnConnIpsecConfig(conn.IpsecConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"vpn_conn_id":    conn.VpnConnId,
+		"vpn_conn_name":  conn.VpnConnName,
+		"local_ip":       conn.LocalIp,
+		"local_subnets":  conn.LocalSubnets,
+		"remote_ip":      conn.RemoteIp,
+		"remote_subnets": conn.RemoteSubnets,
+		"description":    conn.Description,
+		"status":         conn.Status,
+		"health_status":  conn.HealthStatus,
+		"created_time":   conn.CreatedTime,
+		"ike_config":     ikeConfig,
+		"ipsec_config":   ipsecConfig,
+	}, nil
+}
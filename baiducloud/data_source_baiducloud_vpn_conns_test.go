@@ -0,0 +1,52 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccVpnConnsDataSourceName          = "data.baiducloud_vpn_conns.default"
+	testAccVpnConnsDataSourceAttrKeyPrefix = "vpn_conns.0."
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudVpnConnsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpnConnsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccVpnConnsDataSourceName),
+					resource.TestCheckResourceAttr(testAccVpnConnsDataSourceName, "vpn_conns.#", "1"),
+					resource.TestCheckResourceAttr(testAccVpnConnsDataSourceName, testAccVpnConnsDataSourceAttrKeyPrefix+"vpn_conn_name", testAccVpnConnResourceAttrName),
+					resource.TestCheckResourceAttr(testAccVpnConnsDataSourceName, testAccVpnConnsDataSourceAttrKeyPrefix+"remote_ip", "11.11.11.133"),
+					resource.TestCheckResourceAttr(testAccVpnConnsDataSourceName, testAccVpnConnsDataSourceAttrKeyPrefix+"ike_config.0.ike_lifetime", "300"),
+					resource.TestCheckResourceAttr(testAccVpnConnsDataSourceName, testAccVpnConnsDataSourceAttrKeyPrefix+"ipsec_config.0.ipsec_lifetime", "180"),
+					resource.TestCheckResourceAttrSet(testAccVpnConnsDataSourceName, testAccVpnConnsDataSourceAttrKeyPrefix+"vpn_conn_id"),
+					resource.TestCheckResourceAttrSet(testAccVpnConnsDataSourceName, testAccVpnConnsDataSourceAttrKeyPrefix+"status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVpnConnsDataSourceConfig() string {
+	return testAccVpnConnConfig(testAccVpnConnResourceAttrName, "192.168.100.0/24", 300) + fmt.Sprintf(`
+data "baiducloud_vpn_conns" "default" {
+  vpn_id = baiducloud_vpn_conn.default.vpn_id
+
+  filter {
+    name = "vpn_conn_name"
+    values = ["%s"]
+  }
+}
+`, testAccVpnConnResourceAttrName)
+}
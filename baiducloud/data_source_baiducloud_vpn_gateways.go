@@ -0,0 +1,223 @@
+/*
+Use this data source to query VPN gateway list.
+
+Example Usage
+
+```hcl
+data "baiducloud_vpn_gateways" "default" {
+ vpc_id = "vpc-y4p102r3mz6m"
+}
+
+output "vpn_gateways" {
+ value = "${data.baiducloud_vpn_gateways.default.vpn_gateways}"
+}
+```
+*/
+package baiducloud
+
+import (
+	"fmt"
+
+	"github.com/baidubce/bce-sdk-go/services/vpn"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudVpnGateways() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudVpnGatewaysRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Description: "VPC ID where the VPN gateways located.",
+				Optional:    true,
+			},
+			"vpn_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the VPN gateway to retrieve.",
+				Optional:    true,
+			},
+			"eip": {
+				Type:        schema.TypeString,
+				Description: "Specify the EIP binded by the VPN gateway to retrieve.",
+				Optional:    true,
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Output file for saving result.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"filter": dataSourceFiltersSchema(),
+
+			// Attributes used for result
+			"vpn_gateways": {
+				Type:        schema.TypeList,
+				Description: "The list of VPN gateways.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the VPN gateway.",
+							Computed:    true,
+						},
+						"vpn_name": {
+							Type:        schema.TypeString,
+							Description: "Name of the VPN gateway.",
+							Computed:    true,
+						},
+						"vpc_id": {
+							Type:        schema.TypeString,
+							Description: "VPC ID of the VPN gateway.",
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "Description of the VPN gateway.",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Status of the VPN gateway.",
+							Computed:    true,
+						},
+						"eip": {
+							Type:        schema.TypeString,
+							Description: "EIP of the VPN gateway.",
+							Computed:    true,
+						},
+						"bandwidth_in_mbps": {
+							Type:        schema.TypeInt,
+							Description: "Bandwidth of the EIP associated with the VPN gateway in Mbps.",
+							Computed:    true,
+						},
+						"vpn_conn_num": {
+							Type:        schema.TypeInt,
+							Description: "Number of the VPN conns of the VPN gateway.",
+							Computed:    true,
+						},
+						"vpn_conns": {
+							Type:        schema.TypeList,
+							Description: "IDs of the VPN conns of the VPN gateway.",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"payment_timing": {
+							Type:        schema.TypeString,
+							Description: "Payment timing of the VPN gateway.",
+							Computed:    true,
+						},
+						"expired_time": {
+							Type:        schema.TypeString,
+							Description: "Expired time of the VPN gateway.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudVpnGatewaysRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	vpnService := VpnService{client}
+
+	var (
+		vpcID      string
+		vpnID      string
+		eip        string
+		outputFile string
+	)
+	if v, ok := d.GetOk("vpc_id"); ok {
+		vpcID = v.(string)
+	}
+	if v, ok := d.GetOk("vpn_id"); ok {
+		vpnID = v.(string)
+	}
+	if v, ok := d.GetOk("eip"); ok {
+		eip = v.(string)
+	}
+	if v, ok := d.GetOk("output_file"); ok {
+		outputFile = v.(string)
+	}
+
+	action := "Query VPN Gateways " + vpcID + "_" + vpnID + "_" + eip
+
+	if vpcID == "" && vpnID == "" {
+		err := fmt.Errorf("The VPC ID and VPN ID cannot be empty at the same time.")
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_gateways", action, BCESDKGoERROR)
+	}
+
+	vpnsResult := make([]map[string]interface{}, 0)
+	if vpcID != "" {
+		args := &vpn.ListVpnGatewayArgs{
+			VpcId: vpcID,
+			Eip:   eip,
+		}
+		vpns, err := vpnService.ListAllVpnGateways(args)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_gateways", action, BCESDKGoERROR)
+		}
+		for _, gateway := range vpns {
+			if vpnID != "" && gateway.VpnId != vpnID {
+				continue
+			}
+			vpnsResult = append(vpnsResult, flattenVpnGateway(&gateway))
+		}
+	} else {
+		raw, err := client.WithVpnClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
+			return vpnClient.GetVpnGatewayDetail(vpnID)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_gateways", action, BCESDKGoERROR)
+		}
+
+		result, _ := raw.(*vpn.VPN)
+		if eip == "" || result.Eip == eip {
+			vpnsResult = append(vpnsResult, flattenVpnGateway(result))
+		}
+	}
+
+	FilterDataSourceResult(d, &vpnsResult)
+	d.Set("vpn_gateways", vpnsResult)
+
+	d.SetId(resource.UniqueId())
+
+	if outputFile != "" {
+		if err := writeToFile(outputFile, vpnsResult); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_gateways", action, BCESDKGoERROR)
+		}
+	}
+
+	return nil
+}
+
+func flattenVpnGateway(gateway *vpn.VPN) map[string]interface{} {
+	vpnMap := make(map[string]interface{})
+
+	vpnConns := make([]string, 0, len(gateway.VpnConns))
+	for _, conn := range gateway.VpnConns {
+		vpnConns = append(vpnConns, conn.VpnConnId)
+	}
+
+	vpnMap["id"] = gateway.VpnId
+	vpnMap["vpn_name"] = gateway.Name
+	vpnMap["vpc_id"] = gateway.VpcId
+	vpnMap["description"] = gateway.Description
+	vpnMap["status"] = string(gateway.Status)
+	vpnMap["eip"] = gateway.Eip
+	vpnMap["bandwidth_in_mbps"] = gateway.BandwidthInMbps
+	vpnMap["vpn_conn_num"] = gateway.VpnConnNum
+	vpnMap["vpn_conns"] = vpnConns
+	vpnMap["payment_timing"] = gateway.ProductType
+	vpnMap["expired_time"] = gateway.ExpiredTime
+
+	return vpnMap
+}
@@ -0,0 +1,76 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccVpnGatewaysDataSourceName          = "data.baiducloud_vpn_gateways.default"
+	testAccVpnGatewaysDataSourceAttrKeyPrefix = "vpn_gateways.0."
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudVpnGatewaysDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpnGatewaysDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccVpnGatewaysDataSourceName),
+					resource.TestCheckResourceAttr(testAccVpnGatewaysDataSourceName, "vpn_gateways.#", "1"),
+					resource.TestCheckResourceAttr(testAccVpnGatewaysDataSourceName, testAccVpnGatewaysDataSourceAttrKeyPrefix+"vpn_name", testAccVpnGatewayResourceAttrName),
+					resource.TestCheckResourceAttrSet(testAccVpnGatewaysDataSourceName, testAccVpnGatewaysDataSourceAttrKeyPrefix+"id"),
+					resource.TestCheckResourceAttrSet(testAccVpnGatewaysDataSourceName, testAccVpnGatewaysDataSourceAttrKeyPrefix+"vpc_id"),
+					resource.TestCheckResourceAttrSet(testAccVpnGatewaysDataSourceName, testAccVpnGatewaysDataSourceAttrKeyPrefix+"status"),
+					resource.TestCheckResourceAttr(testAccVpnGatewaysDataSourceName, testAccVpnGatewaysDataSourceAttrKeyPrefix+"payment_timing", "Postpaid"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVpnGatewaysDataSourceConfig() string {
+	return fmt.Sprintf(`
+resource "baiducloud_vpc" "default" {
+  name = "%s"
+  cidr = "192.168.0.0/16"
+}
+
+data "baiducloud_zones" "default" {}
+
+resource "baiducloud_subnet" "default" {
+  name      = "%s"
+  zone_name = data.baiducloud_zones.default.zones.0.zone_name
+  cidr      = "192.168.1.0/24"
+  vpc_id    = baiducloud_vpc.default.id
+}
+
+resource "baiducloud_vpn_gateway" "default" {
+  vpn_name = "%s"
+  vpc_id   = baiducloud_vpc.default.id
+  billing = {
+    payment_timing = "Postpaid"
+  }
+  depends_on = [baiducloud_subnet.default]
+}
+
+data "baiducloud_vpn_gateways" "default" {
+  vpc_id = baiducloud_vpn_gateway.default.vpc_id
+
+  filter {
+    name = "vpn_name"
+    values = ["test-BaiduAcc*"]
+  }
+}
+`, BaiduCloudTestResourceAttrNamePrefix+"VPC",
+		BaiduCloudTestResourceAttrNamePrefix+"Subnet",
+		testAccVpnGatewayResourceAttrName)
+}
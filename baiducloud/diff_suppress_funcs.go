@@ -46,6 +46,25 @@ func appServerGroupPortHealthCheckUDPSuppressFunc(k, old, new string, d *schema.
 	return false
 }
 
+func appIpGroupBackendPolicyHTTPSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	strs := strings.Split(k, ".")
+	if len(strs) == 3 {
+		value := d.Get("backend_policy_list." + strs[1] + ".type").(string)
+		return value != HTTP && value != HTTPS
+	}
+
+	return false
+}
+
+func appIpGroupBackendPolicyUDPSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	strs := strings.Split(k, ".")
+	if len(strs) == 3 {
+		return d.Get("backend_policy_list."+strs[1]+".type").(string) != UDP
+	}
+
+	return false
+}
+
 func appBlbProtocolTCPUDPSSLSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	if v, ok := d.GetOk("protocol"); ok {
 		return stringInSlice([]string{TCP, UDP, SSL}, v.(string))
@@ -74,3 +93,20 @@ func cfcTriggerSourceTypeSuppressFunc(sourceType []string) schema.SchemaDiffSupp
 		return true
 	}
 }
+
+func pemDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return normalizePEM(old) == normalizePEM(new)
+}
+
+func bosBucketPolicyDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	oldPolicy, err := normalizeBosBucketPolicy(old)
+	if err != nil {
+		return false
+	}
+	newPolicy, err := normalizeBosBucketPolicy(new)
+	if err != nil {
+		return false
+	}
+
+	return oldPolicy == newPolicy
+}
@@ -73,6 +73,13 @@ var (
 
 	// replication configuration error
 	ReplicationConfigurationNotFound = []string{"NoReplicationConfiguration"}
+	BucketReplicationNotFound        = []string{"NoSuchBucket", "NoReplicationConfiguration", "Not Found"}
+
+	// bos object error
+	BosObjectNotFound = []string{"NoSuchKey", "NoSuchBucket", "Not Found"}
+
+	// bucket notification error
+	BucketNotificationNotFound = []string{"NoSuchBucket", "NoSuchNotification", "Not Found"}
 
 	// cce error
 	CceClusterNotFound = []string{CceNotFound}
@@ -10,6 +10,14 @@ const (
 	SSL   = "SSL"
 )
 
+const (
+	AppServerGroupType = "Server"
+	AppIpGroupType     = "Ip"
+)
+
+// health check status of backend server port, the others are Dead and Unknown
+const AppRsPortStatusAlive = "Alive"
+
 var APPBLBProcessingStatus = []string{
 	string(appblb.BLBStatusCreating),
 	string(appblb.BLBStatusUpdating),
@@ -25,3 +33,6 @@ var APPBLBFailedStatus = []string{
 }
 
 var TransportProtocol = []string{TCP, UDP, SSL}
+
+// "uri" matches the request path, "query", "header" and "cookie" only work on HTTP/HTTPS listener
+var AppBlbPolicyRuleKeys = []string{"*", "host", "uri", "query", "header", "cookie"}
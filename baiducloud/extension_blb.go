@@ -0,0 +1,17 @@
+package baiducloud
+
+import "github.com/baidubce/bce-sdk-go/services/blb"
+
+var BLBProcessingStatus = []string{
+	string(blb.BLBStatusCreating),
+	string(blb.BLBStatusUpdating),
+}
+
+var BLBAvailableStatus = []string{
+	string(blb.BLBStatusAvailable),
+}
+
+var BLBFailedStatus = []string{
+	string(blb.BLBStatusUnavailable),
+	string(blb.BLBStatusPaused),
+}
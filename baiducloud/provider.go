@@ -22,15 +22,24 @@ Resources List
 Data Sources
   baiducloud_vpcs
   baiducloud_subnets
+  baiducloud_subnet_ip_usage
   baiducloud_route_rules
   baiducloud_acls
   baiducloud_nat_gateways
   baiducloud_peer_conns
+  baiducloud_vpn_gateways
+  baiducloud_vpn_conns
+  baiducloud_et_gateways
   baiducloud_bos_buckets
   baiducloud_bos_bucket_objects
+  baiducloud_bos_bucket_trash_objects
+  baiducloud_bos_object
+  baiducloud_bos_presigned_url
   baiducloud_appblbs
   baiducloud_appblb_listeners
   baiducloud_appblb_server_groups
+  baiducloud_appblb_backend_health
+  baiducloud_blbs
   baiducloud_eips
   baiducloud_instances
   baiducloud_cdss
@@ -65,7 +74,16 @@ EIP Resources
 APPBLB Resources
   baiducloud_appblb
   baiducloud_appblb_server_group
+  baiducloud_appblb_server_group_member
   baiducloud_appblb_listener
+  baiducloud_appblb_listener_policy
+  baiducloud_appblb_ip_group
+  baiducloud_appblb_ip_group_member
+
+BLB Resources
+  baiducloud_blb
+  baiducloud_blb_listener
+  baiducloud_blb_backend_server
 
 BCC Resources
   baiducloud_instance
@@ -85,9 +103,24 @@ VPC Resources
   baiducloud_peer_conn
   baiducloud_peer_conn_acceptor
 
+VPN Resources
+  baiducloud_vpn_gateway
+  baiducloud_vpn_conn
+
+ET Resources
+  baiducloud_et_gateway
+  baiducloud_et_channel_association
+
 BOS Resources
   baiducloud_bos_bucket
+  baiducloud_bos_bucket_notification
   baiducloud_bos_bucket_object
+  baiducloud_bos_bucket_objects_sync
+  baiducloud_bos_bucket_policy
+  baiducloud_bos_bucket_replication
+  baiducloud_bos_object_acl
+  baiducloud_bos_object_copy
+  baiducloud_bos_object_symlink
 
 CFC Resources
   baiducloud_cfc_function
@@ -168,15 +201,24 @@ func Provider() terraform.ResourceProvider {
 		DataSourcesMap: map[string]*schema.Resource{
 			"baiducloud_vpcs":                           dataSourceBaiduCloudVpcs(),
 			"baiducloud_subnets":                        dataSourceBaiduCloudSubnets(),
+			"baiducloud_subnet_ip_usage":                dataSourceBaiduCloudSubnetIpUsage(),
 			"baiducloud_route_rules":                    dataSourceBaiduCloudRouteRules(),
 			"baiducloud_acls":                           dataSourceBaiduCloudAcls(),
 			"baiducloud_nat_gateways":                   dataSourceBaiduCloudNatGateways(),
 			"baiducloud_peer_conns":                     dataSourceBaiduCloudPeerConns(),
+			"baiducloud_vpn_gateways":                   dataSourceBaiduCloudVpnGateways(),
+			"baiducloud_vpn_conns":                      dataSourceBaiduCloudVpnConns(),
+			"baiducloud_et_gateways":                    dataSourceBaiduCloudEtGateways(),
 			"baiducloud_bos_buckets":                    dataSourceBaiduCloudBosBuckets(),
 			"baiducloud_bos_bucket_objects":             dataSourceBaiduCloudBosBucketObjects(),
+			"baiducloud_bos_bucket_trash_objects":       dataSourceBaiduCloudBosBucketTrashObjects(),
+			"baiducloud_bos_object":                     dataSourceBaiduCloudBosObject(),
+			"baiducloud_bos_presigned_url":              dataSourceBaiduCloudBosPresignedUrl(),
 			"baiducloud_appblbs":                        dataSourceBaiduCloudAppBLBs(),
 			"baiducloud_appblb_listeners":               dataSourceBaiduCloudAppBLBListeners(),
 			"baiducloud_appblb_server_groups":           dataSourceBaiduCloudAppBLBServerGroups(),
+			"baiducloud_appblb_backend_health":          dataSourceBaiduCloudAppBLBBackendHealth(),
+			"baiducloud_blbs":                           dataSourceBaiduCloudBLBs(),
 			"baiducloud_certs":                          dataSourceBaiduCloudCerts(),
 			"baiducloud_eips":                           dataSourceBaiduCloudEips(),
 			"baiducloud_instances":                      dataSourceBaiduCloudInstances(),
@@ -221,10 +263,28 @@ func Provider() terraform.ResourceProvider {
 			"baiducloud_appblb":                      resourceBaiduCloudAppBLB(),
 			"baiducloud_peer_conn":                   resourceBaiduCloudPeerConn(),
 			"baiducloud_peer_conn_acceptor":          resourceBaiduCloudPeerConnAcceptor(),
+			"baiducloud_vpn_gateway":                 resourceBaiduCloudVpnGateway(),
+			"baiducloud_vpn_conn":                    resourceBaiduCloudVpnConn(),
+			"baiducloud_et_gateway":                  resourceBaiduCloudEtGateway(),
+			"baiducloud_et_channel_association":      resourceBaiduCloudEtChannelAssociation(),
 			"baiducloud_appblb_server_group":         resourceBaiduCloudAppBlbServerGroup(),
+			"baiducloud_appblb_server_group_member":  resourceBaiduCloudAppBlbServerGroupMember(),
 			"baiducloud_appblb_listener":             resourceBaiduCloudAppBlbListener(),
+			"baiducloud_appblb_listener_policy":      resourceBaiduCloudAppBlbListenerPolicy(),
+			"baiducloud_appblb_ip_group":             resourceBaiduCloudAppBlbIpGroup(),
+			"baiducloud_appblb_ip_group_member":      resourceBaiduCloudAppBlbIpGroupMember(),
+			"baiducloud_blb":                         resourceBaiduCloudBLB(),
+			"baiducloud_blb_listener":                resourceBaiduCloudBlbListener(),
+			"baiducloud_blb_backend_server":          resourceBaiduCloudBlbBackendServer(),
 			"baiducloud_bos_bucket":                  resourceBaiduCloudBosBucket(),
+			"baiducloud_bos_bucket_notification":     resourceBaiduCloudBosBucketNotification(),
 			"baiducloud_bos_bucket_object":           resourceBaiduCloudBucketObject(),
+			"baiducloud_bos_bucket_objects_sync":     resourceBaiduCloudBosBucketObjectsSync(),
+			"baiducloud_bos_bucket_policy":           resourceBaiduCloudBosBucketPolicy(),
+			"baiducloud_bos_bucket_replication":      resourceBaiduCloudBosBucketReplication(),
+			"baiducloud_bos_object_acl":              resourceBaiduCloudBosObjectAcl(),
+			"baiducloud_bos_object_copy":             resourceBaiduCloudBosObjectCopy(),
+			"baiducloud_bos_object_symlink":          resourceBaiduCloudBosObjectSymlink(),
 			"baiducloud_cert":                        resourceBaiduCloudCert(),
 			"baiducloud_cfc_function":                resourceBaiduCloudCFCFunction(),
 			"baiducloud_cfc_alias":                   resourceBaiduCloudCFCAlias(),
@@ -437,6 +497,8 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		endpoints := endpointsSetI.(map[string]interface{})
 		config.ConfigEndpoints[connectivity.BCCCode] = strings.TrimSpace(endpoints["bcc"].(string))
 		config.ConfigEndpoints[connectivity.VPCCode] = strings.TrimSpace(endpoints["vpc"].(string))
+		config.ConfigEndpoints[connectivity.VPNCode] = strings.TrimSpace(endpoints["vpc"].(string))
+		config.ConfigEndpoints[connectivity.ETCode] = strings.TrimSpace(endpoints["vpc"].(string))
 		config.ConfigEndpoints[connectivity.EIPCode] = strings.TrimSpace(endpoints["eip"].(string))
 		config.ConfigEndpoints[connectivity.APPBLBCode] = strings.TrimSpace(endpoints["appblb"].(string))
 		config.ConfigEndpoints[connectivity.BOSCode] = strings.TrimSpace(endpoints["bos"].(string))
@@ -9,6 +9,7 @@ resource "baiducloud_appblb" "default" {
   description = "this is a test LoadBalance instance"
   vpc_id      = "vpc-gxaava4knqr1"
   subnet_id   = "sbn-m4x3f2i6c901"
+  eip         = "100.88.9.120"
 
   tags = {
     "tagAKey" = "tagAValue"
@@ -96,6 +97,12 @@ func resourceBaiduCloudAppBLB() *schema.Resource {
 				Description: "LoadBalance instance's public ip",
 				Computed:    true,
 			},
+			"eip": {
+				Type:         schema.TypeString,
+				Description:  "EIP bound to the LoadBalance instance to make it internet-facing. Changing it binds the new EIP in place, and removing it unbinds the EIP. Do not use it together with baiducloud_eip_association for the same instance",
+				Optional:     true,
+				ValidateFunc: validation.SingleIP(),
+			},
 			"cidr": {
 				Type:        schema.TypeString,
 				Description: "Cidr of the network where the LoadBalance instance reside",
@@ -190,6 +197,12 @@ func resourceBaiduCloudAppBLBCreate(d *schema.ResourceData, meta interface{}) er
 		return WrapError(err)
 	}
 
+	if v, ok := d.GetOk("eip"); ok && v.(string) != "" {
+		if err := bindAppBlbEip(d, meta, v.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb", action, BCESDKGoERROR)
+		}
+	}
+
 	return resourceBaiduCloudAppBLBRead(d, meta)
 }
 func resourceBaiduCloudAppBLBRead(d *schema.ResourceData, meta interface{}) error {
@@ -218,6 +231,10 @@ func resourceBaiduCloudAppBLBRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("subnet_name", blbDetail.SubnetName)
 	d.Set("cidr", blbDetail.Cidr)
 	d.Set("public_ip", blbDetail.PublicIp)
+	// only track eip when it is managed by this resource, so that binding by baiducloud_eip_association does not make a diff
+	if v, ok := d.GetOk("eip"); ok && v.(string) != "" {
+		d.Set("eip", blbDetail.PublicIp)
+	}
 	d.Set("subnet_cidr", blbDetail.SubnetCider)
 	d.Set("create_time", blbDetail.CreateTime)
 	d.Set("release_time", blbDetail.ReleaseTime)
@@ -277,6 +294,24 @@ func resourceBaiduCloudAppBLBUpdate(d *schema.ResourceData, meta interface{}) er
 		d.SetPartial("description")
 	}
 
+	if d.HasChange("eip") {
+		d.Partial(true)
+
+		o, n := d.GetChange("eip")
+		if o.(string) != "" {
+			if err := unbindAppBlbEip(d, meta, o.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb", action, BCESDKGoERROR)
+			}
+		}
+		if n.(string) != "" {
+			if err := bindAppBlbEip(d, meta, n.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb", action, BCESDKGoERROR)
+			}
+		}
+
+		d.SetPartial("eip")
+	}
+
 	d.Partial(false)
 	return resourceBaiduCloudAppBLBRead(d, meta)
 }
@@ -312,6 +347,52 @@ func resourceBaiduCloudAppBLBDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+func bindAppBlbEip(d *schema.ResourceData, meta interface{}, eipAddress string, timeout time.Duration) error {
+	client := meta.(*connectivity.BaiduClient)
+	eipService := EipService{client}
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		err := eipService.EipBind(eipAddress, "BLB", d.Id())
+		addDebug("Bind EIP "+eipAddress+" with APPBLB "+d.Id(), err)
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	stateConf := buildStateConf(EIPProcessingStatus,
+		[]string{EIPStatusBinded},
+		timeout,
+		eipService.EipStateRefreshFunc(eipAddress, append(EIPFailedStatus, EIPStatusAvailable)))
+	_, err = stateConf.WaitForState()
+
+	return err
+}
+
+func unbindAppBlbEip(d *schema.ResourceData, meta interface{}, eipAddress string, timeout time.Duration) error {
+	client := meta.(*connectivity.BaiduClient)
+	eipService := EipService{client}
+
+	if err := eipService.EipUnBind(eipAddress); err != nil {
+		return err
+	}
+
+	stateConf := buildStateConf(EIPProcessingStatus,
+		[]string{EIPStatusAvailable},
+		timeout,
+		eipService.EipStateRefreshFunc(eipAddress, EIPFailedStatus))
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
 func buildBaiduCloudCreateAppBlbArgs(d *schema.ResourceData) *appblb.CreateLoadBalancerArgs {
 	result := &appblb.CreateLoadBalancerArgs{
 		ClientToken: buildClientToken(),
@@ -0,0 +1,429 @@
+/*
+Provide a resource to create an APPBLB IP Group.
+
+Example Usage
+
+```hcl
+resource "baiducloud_appblb_ip_group" "default" {
+  name        = "testIpGroup"
+  description = "this is a test IP Group"
+  blb_id      = "lb-0d29a3f6"
+
+  backend_policy_list {
+    type                           = "TCP"
+    health_check_port              = 80
+    health_check_timeout_in_second = 3
+  }
+
+  backend_policy_list {
+    type                  = "HTTP"
+    health_check_url_path = "/health"
+  }
+}
+```
+*/
+package baiducloud
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/services/appblb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudAppBlbIpGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudAppBlbIpGroupCreate,
+		Read:   resourceBaiduCloudAppBlbIpGroupRead,
+		Update: resourceBaiduCloudAppBlbIpGroupUpdate,
+		Delete: resourceBaiduCloudAppBlbIpGroupDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"blb_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the Application LoadBalance instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "Name of the IP Group, length must be between 1 and 65 bytes, and will be automatically generated if not set",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 65),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Description:  "IP Group's description, length must be between 0 and 450 bytes, and support Chinese",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 450),
+			},
+			"backend_policy_list": {
+				Type:        schema.TypeList,
+				Description: "IP Group backend policy list, each protocol type can only have one policy",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "IP Group backend policy id",
+							Computed:    true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Description:  "IP Group backend policy protocol type, support TCP/UDP/HTTP/HTTPS, health check protocol is the same as this type",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{TCP, UDP, HTTP, HTTPS}, false),
+						},
+						"health_check_port": {
+							Type:         schema.TypeInt,
+							Description:  "IP Group health check port, default same as member port",
+							Computed:     true,
+							Optional:     true,
+							ValidateFunc: validatePort(),
+						},
+						"health_check_timeout_in_second": {
+							Type:         schema.TypeInt,
+							Description:  "IP Group health check timeout(second), support in [1, 60], default 3",
+							Computed:     true,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 60),
+						},
+						"health_check_interval_in_second": {
+							Type:         schema.TypeInt,
+							Description:  "IP Group health check interval time(second), support in [1, 10], default 3",
+							Computed:     true,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+						"health_check_down_retry": {
+							Type:         schema.TypeInt,
+							Description:  "IP Group health check down retry time, support in [2, 5], default 3",
+							Computed:     true,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(2, 5),
+						},
+						"health_check_up_retry": {
+							Type:         schema.TypeInt,
+							Description:  "IP Group health check up retry time, support in [2, 5], default 3",
+							Computed:     true,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(2, 5),
+						},
+						"health_check_normal_status": {
+							Type:             schema.TypeString,
+							Description:      "IP Group health check normal http status code, only useful when type is HTTP/HTTPS",
+							Computed:         true,
+							Optional:         true,
+							DiffSuppressFunc: appIpGroupBackendPolicyHTTPSuppressFunc,
+						},
+						"health_check_url_path": {
+							Type:             schema.TypeString,
+							Description:      "IP Group health check url path, only useful when type is HTTP/HTTPS",
+							Computed:         true,
+							Optional:         true,
+							DiffSuppressFunc: appIpGroupBackendPolicyHTTPSuppressFunc,
+						},
+						"udp_health_check_string": {
+							Type:             schema.TypeString,
+							Description:      "IP Group udp health check string, if type is UDP, this parameter is required",
+							Computed:         true,
+							Optional:         true,
+							DiffSuppressFunc: appIpGroupBackendPolicyUDPSuppressFunc,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudAppBlbIpGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	createArgs := &appblb.CreateAppIpGroupArgs{
+		ClientToken: buildClientToken(),
+	}
+	if v, ok := d.GetOk("name"); ok && v.(string) != "" {
+		createArgs.Name = v.(string)
+	}
+	if v, ok := d.GetOk("description"); ok && v.(string) != "" {
+		createArgs.Desc = v.(string)
+	}
+
+	blbId := d.Get("blb_id").(string)
+	action := "Create AppBlb " + blbId + " AppIpGroup " + createArgs.Name
+
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		raw, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+			return client.CreateAppIpGroup(blbId, createArgs)
+		})
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		addDebug(action, raw)
+		response, _ := raw.(*appblb.CreateAppIpGroupResult)
+		d.SetId(response.Id)
+
+		return nil
+	})
+
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_ip_group", action, BCESDKGoERROR)
+	}
+
+	if err := updateAppIpGroupBackendPolicyList(d, meta); err != nil {
+		return err
+	}
+
+	return resourceBaiduCloudAppBlbIpGroupRead(d, meta)
+}
+
+func resourceBaiduCloudAppBlbIpGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	appblbService := APPBLBService{client}
+
+	blbId := d.Get("blb_id").(string)
+	id := d.Id()
+	action := "Query APPBLB " + blbId + " App Ip Group " + id
+
+	group, err := appblbService.AppIpGroupDetail(blbId, id)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_ip_group", action, BCESDKGoERROR)
+	}
+	addDebug(action, group)
+
+	d.Set("name", group.Name)
+	d.Set("description", group.Desc)
+	d.Set("blb_id", blbId)
+
+	if err := d.Set("backend_policy_list", appblbService.FlattenAppIpGroupBackendPoliciesToMap(group.BackendPolicyList)); err != nil {
+		return WrapError(err)
+	}
+
+	return nil
+}
+
+func resourceBaiduCloudAppBlbIpGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	blbId := d.Get("blb_id").(string)
+	id := d.Id()
+	action := "Update APPBLB " + blbId + " App Ip Group " + id
+
+	d.Partial(true)
+	if d.HasChange("name") || d.HasChange("description") {
+		args := &appblb.UpdateAppIpGroupArgs{
+			IpGroupId:   id,
+			Name:        d.Get("name").(string),
+			Desc:        d.Get("description").(string),
+			ClientToken: buildClientToken(),
+		}
+
+		_, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+			return nil, client.UpdateAppIpGroup(blbId, args)
+		})
+		addDebug(action, args)
+
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_ip_group", action, BCESDKGoERROR)
+		}
+
+		d.SetPartial("name")
+		d.SetPartial("description")
+	}
+
+	if d.HasChange("backend_policy_list") {
+		if err := updateAppIpGroupBackendPolicyList(d, meta); err != nil {
+			return err
+		}
+		d.SetPartial("backend_policy_list")
+	}
+
+	d.Partial(false)
+	return resourceBaiduCloudAppBlbIpGroupRead(d, meta)
+}
+
+func resourceBaiduCloudAppBlbIpGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	blbId := d.Get("blb_id").(string)
+	id := d.Id()
+	deleteArgs := &appblb.DeleteAppIpGroupArgs{
+		IpGroupId:   id,
+		ClientToken: buildClientToken(),
+	}
+	action := "Delete APPBLB " + blbId + " App Ip Group " + id
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+			return id, client.DeleteAppIpGroup(blbId, deleteArgs)
+		})
+		addDebug(action, id)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if IsExceptedErrors(err, ObjectNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_ip_group", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func updateAppIpGroupBackendPolicyList(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	appblbService := APPBLBService{client}
+
+	blbId := d.Get("blb_id").(string)
+	id := d.Id()
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	o, n := d.GetChange("backend_policy_list")
+	oldMap, err := appIpGroupBackendPolicyListToMap(o.([]interface{}))
+	if err != nil {
+		return WrapError(err)
+	}
+	newMap, err := appIpGroupBackendPolicyListToMap(n.([]interface{}))
+	if err != nil {
+		return WrapError(err)
+	}
+
+	removeIds := make([]string, 0)
+	for policyType, oldPolicy := range oldMap {
+		if _, ok := newMap[policyType]; !ok {
+			removeIds = append(removeIds, oldPolicy["id"].(string))
+		}
+	}
+	if len(removeIds) > 0 {
+		args := &appblb.DeleteAppIpGroupBackendPolicyArgs{
+			IpGroupId:           id,
+			BackendPolicyIdList: removeIds,
+			ClientToken:         buildClientToken(),
+		}
+		if err := appblbService.DeleteAppIpGroupBackendPolicy(blbId, args, timeout); err != nil {
+			return err
+		}
+	}
+
+	for policyType, newPolicy := range newMap {
+		oldPolicy, ok := oldMap[policyType]
+		if !ok {
+			args := buildAppIpGroupCreateBackendPolicyArgs(id, newPolicy)
+			if err := appblbService.CreateAppIpGroupBackendPolicy(blbId, args, timeout); err != nil {
+				return err
+			}
+			continue
+		}
+
+		newPolicy["id"] = oldPolicy["id"]
+		if !reflect.DeepEqual(oldPolicy, newPolicy) {
+			args := buildAppIpGroupUpdateBackendPolicyArgs(id, newPolicy)
+			if err := appblbService.UpdateAppIpGroupBackendPolicy(blbId, args, timeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func appIpGroupBackendPolicyListToMap(list []interface{}) (map[string]map[string]interface{}, error) {
+	result := make(map[string]map[string]interface{}, len(list))
+
+	for _, v := range list {
+		policy := v.(map[string]interface{})
+		policyType := policy["type"].(string)
+
+		if _, ok := result[policyType]; ok {
+			return nil, fmt.Errorf("IP Group backend policy type %s can only be set once", policyType)
+		}
+		if policyType == UDP && policy["udp_health_check_string"].(string) == "" {
+			return nil, fmt.Errorf("udp_health_check_string is required when IP Group backend policy type is UDP")
+		}
+
+		result[policyType] = policy
+	}
+
+	return result, nil
+}
+
+func buildAppIpGroupCreateBackendPolicyArgs(ipGroupId string, policy map[string]interface{}) *appblb.CreateAppIpGroupBackendPolicyArgs {
+	result := &appblb.CreateAppIpGroupBackendPolicyArgs{
+		ClientToken:                 buildClientToken(),
+		IpGroupId:                   ipGroupId,
+		Type:                        policy["type"].(string),
+		HealthCheckPort:             policy["health_check_port"].(int),
+		HealthCheckTimeoutInSecond:  policy["health_check_timeout_in_second"].(int),
+		HealthCheckIntervalInSecond: policy["health_check_interval_in_second"].(int),
+		HealthCheckDownRetry:        policy["health_check_down_retry"].(int),
+		HealthCheckUpRetry:          policy["health_check_up_retry"].(int),
+	}
+
+	switch result.Type {
+	case HTTP, HTTPS:
+		result.HealthCheckNormalStatus = policy["health_check_normal_status"].(string)
+		result.HealthCheckUrlPath = policy["health_check_url_path"].(string)
+	case UDP:
+		result.UdpHealthCheckString = policy["udp_health_check_string"].(string)
+	}
+
+	return result
+}
+
+func buildAppIpGroupUpdateBackendPolicyArgs(ipGroupId string, policy map[string]interface{}) *appblb.UpdateAppIpGroupBackendPolicyArgs {
+	result := &appblb.UpdateAppIpGroupBackendPolicyArgs{
+		ClientToken:                 buildClientToken(),
+		IpGroupId:                   ipGroupId,
+		Id:                          policy["id"].(string),
+		HealthCheckPort:             policy["health_check_port"].(int),
+		HealthCheckTimeoutInSecond:  policy["health_check_timeout_in_second"].(int),
+		HealthCheckIntervalInSecond: policy["health_check_interval_in_second"].(int),
+		HealthCheckDownRetry:        policy["health_check_down_retry"].(int),
+		HealthCheckUpRetry:          policy["health_check_up_retry"].(int),
+	}
+
+	switch policy["type"].(string) {
+	case HTTP, HTTPS:
+		result.HealthCheckNormalStatus = policy["health_check_normal_status"].(string)
+		result.HealthCheckUrlPath = policy["health_check_url_path"].(string)
+	case UDP:
+		result.UdpHealthCheckString = policy["udp_health_check_string"].(string)
+	}
+
+	return result
+}
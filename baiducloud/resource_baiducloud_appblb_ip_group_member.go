@@ -0,0 +1,231 @@
+/*
+Provide a resource to add a member to an APPBLB IP Group. The member can be an IP of IDC or ENI which is reachable from the BLB.
+
+Example Usage
+
+```hcl
+resource "baiducloud_appblb_ip_group_member" "default" {
+  blb_id      = "lb-0d29a3f6"
+  ip_group_id = "ip_group-a1b2c3d4"
+  ip          = "192.168.0.10"
+  port        = 8080
+  weight      = 50
+}
+```
+*/
+package baiducloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/services/appblb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudAppBlbIpGroupMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudAppBlbIpGroupMemberCreate,
+		Read:   resourceBaiduCloudAppBlbIpGroupMemberRead,
+		Update: resourceBaiduCloudAppBlbIpGroupMemberUpdate,
+		Delete: resourceBaiduCloudAppBlbIpGroupMemberDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"blb_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the Application LoadBalance instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ip_group_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the IP Group",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ip": {
+				Type:         schema.TypeString,
+				Description:  "IP address of the member",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.SingleIP(),
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Description:  "Port of the member, range from 1-65535",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePort(),
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Description:  "Weight of the member in this group, range from 0-100",
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudAppBlbIpGroupMemberCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	appblbService := APPBLBService{client}
+
+	blbId := d.Get("blb_id").(string)
+	ipGroupId := d.Get("ip_group_id").(string)
+	ip := d.Get("ip").(string)
+	port := d.Get("port").(int)
+	args := &appblb.CreateAppIpGroupMemberArgs{
+		AppIpGroupMemberWriteOpArgs: appblb.AppIpGroupMemberWriteOpArgs{
+			IpGroupId: ipGroupId,
+			MemberList: []appblb.AppIpGroupMember{{
+				Ip:     ip,
+				Port:   port,
+				Weight: d.Get("weight").(int),
+			}},
+			ClientToken: buildClientToken(),
+		},
+	}
+	action := fmt.Sprintf("Create APPBLB %s App Ip Group %s Member [%s:%d]", blbId, ipGroupId, ip, port)
+
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+			return nil, client.CreateAppIpGroupMember(blbId, args)
+		})
+		addDebug(action, args)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_ip_group_member", action, BCESDKGoERROR)
+	}
+
+	// create api does not return the member id, so find it by ip and port
+	members, err := appblbService.AppIpGroupMembers(blbId, ipGroupId)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_ip_group_member", action, BCESDKGoERROR)
+	}
+	for _, member := range members {
+		if member.Ip == ip && member.Port == port {
+			d.SetId(member.MemberId)
+			break
+		}
+	}
+	if d.Id() == "" {
+		return WrapError(fmt.Errorf("member [%s:%d] is not found in App Ip Group %s after created", ip, port, ipGroupId))
+	}
+
+	return resourceBaiduCloudAppBlbIpGroupMemberRead(d, meta)
+}
+
+func resourceBaiduCloudAppBlbIpGroupMemberRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	appblbService := APPBLBService{client}
+
+	blbId := d.Get("blb_id").(string)
+	ipGroupId := d.Get("ip_group_id").(string)
+	action := "Query APPBLB " + blbId + " App Ip Group " + ipGroupId + " Member " + d.Id()
+
+	member, err := appblbService.AppIpGroupMemberDetail(blbId, ipGroupId, d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_ip_group_member", action, BCESDKGoERROR)
+	}
+	addDebug(action, member)
+
+	d.Set("ip", member.Ip)
+	d.Set("port", member.Port)
+	d.Set("weight", member.Weight)
+
+	return nil
+}
+
+func resourceBaiduCloudAppBlbIpGroupMemberUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	blbId := d.Get("blb_id").(string)
+	ipGroupId := d.Get("ip_group_id").(string)
+	action := "Update APPBLB " + blbId + " App Ip Group " + ipGroupId + " Member " + d.Id()
+
+	if d.HasChange("weight") {
+		args := &appblb.UpdateAppIpGroupMemberArgs{
+			AppIpGroupMemberWriteOpArgs: appblb.AppIpGroupMemberWriteOpArgs{
+				IpGroupId: ipGroupId,
+				MemberList: []appblb.AppIpGroupMember{{
+					MemberId: d.Id(),
+					Port:     d.Get("port").(int),
+					Weight:   d.Get("weight").(int),
+				}},
+				ClientToken: buildClientToken(),
+			},
+		}
+
+		_, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+			return nil, client.UpdateAppIpGroupMember(blbId, args)
+		})
+		addDebug(action, args)
+
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_ip_group_member", action, BCESDKGoERROR)
+		}
+	}
+
+	return resourceBaiduCloudAppBlbIpGroupMemberRead(d, meta)
+}
+
+func resourceBaiduCloudAppBlbIpGroupMemberDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	blbId := d.Get("blb_id").(string)
+	ipGroupId := d.Get("ip_group_id").(string)
+	args := &appblb.DeleteAppIpGroupMemberArgs{
+		IpGroupId:    ipGroupId,
+		MemberIdList: []string{d.Id()},
+		ClientToken:  buildClientToken(),
+	}
+	action := "Delete APPBLB " + blbId + " App Ip Group " + ipGroupId + " Member " + d.Id()
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+			return nil, client.DeleteAppIpGroupMember(blbId, args)
+		})
+		addDebug(action, args)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if IsExceptedErrors(err, ObjectNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_ip_group_member", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	testAccAppBLBIpGroupMemberResourceType = "baiducloud_appblb_ip_group_member"
+	testAccAppBLBIpGroupMemberResourceName = testAccAppBLBIpGroupMemberResourceType + "." + BaiduCloudTestResourceName
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudAppBLBIpGroupMember_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAppBLBIpGroupMemberDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBLBIpGroupMemberConfig(50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccAppBLBIpGroupMemberResourceName),
+					resource.TestCheckResourceAttr(testAccAppBLBIpGroupMemberResourceName, "ip", "192.168.0.10"),
+					resource.TestCheckResourceAttr(testAccAppBLBIpGroupMemberResourceName, "port", "8080"),
+					resource.TestCheckResourceAttr(testAccAppBLBIpGroupMemberResourceName, "weight", "50"),
+				),
+			},
+			{
+				Config: testAccAppBLBIpGroupMemberConfig(80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccAppBLBIpGroupMemberResourceName),
+					resource.TestCheckResourceAttr(testAccAppBLBIpGroupMemberResourceName, "weight", "80"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAppBLBIpGroupMemberDestory(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.BaiduClient)
+	appblbService := APPBLBService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccAppBLBIpGroupMemberResourceType {
+			continue
+		}
+
+		_, err := appblbService.AppIpGroupMemberDetail(rs.Primary.Attributes["blb_id"], rs.Primary.Attributes["ip_group_id"], rs.Primary.ID)
+		if err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return WrapError(err)
+		}
+
+		return WrapError(Error("APPBLB IpGroup Member still exist"))
+	}
+
+	return nil
+}
+
+func testAccAppBLBIpGroupMemberConfig(weight int) string {
+	return testAccAppBLBIpGroupConfigBase() + fmt.Sprintf(`
+resource "%s" "default" {
+  name   = "%s"
+  blb_id = baiducloud_appblb.default.id
+}
+
+resource "%s" "%s" {
+  blb_id      = baiducloud_appblb.default.id
+  ip_group_id = %s.default.id
+  ip          = "192.168.0.10"
+  port        = 8080
+  weight      = %d
+}
+`, testAccAppBLBIpGroupResourceType,
+		testAccAppBLBIpGroupResourceAttrName,
+		testAccAppBLBIpGroupMemberResourceType,
+		BaiduCloudTestResourceName,
+		testAccAppBLBIpGroupResourceType,
+		weight)
+}
@@ -0,0 +1,160 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	testAccAppBLBIpGroupResourceType     = "baiducloud_appblb_ip_group"
+	testAccAppBLBIpGroupResourceName     = testAccAppBLBIpGroupResourceType + "." + BaiduCloudTestResourceName
+	testAccAppBLBIpGroupResourceAttrName = BaiduCloudTestResourceAttrNamePrefix + "APPBLBIpGroup"
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudAppBLBIpGroup_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAppBLBIpGroupDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBLBIpGroupConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccAppBLBIpGroupResourceName),
+					resource.TestCheckResourceAttr(testAccAppBLBIpGroupResourceName, "name", testAccAppBLBIpGroupResourceAttrName),
+					resource.TestCheckResourceAttr(testAccAppBLBIpGroupResourceName, "description", "acceptance test"),
+					resource.TestCheckResourceAttr(testAccAppBLBIpGroupResourceName, "backend_policy_list.#", "1"),
+					resource.TestCheckResourceAttr(testAccAppBLBIpGroupResourceName, "backend_policy_list.0.type", "TCP"),
+					resource.TestCheckResourceAttrSet(testAccAppBLBIpGroupResourceName, "backend_policy_list.0.id"),
+					resource.TestCheckResourceAttrSet(testAccAppBLBIpGroupResourceName, "blb_id"),
+				),
+			},
+			{
+				Config: testAccAppBLBIpGroupConfigUpdate(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccAppBLBIpGroupResourceName),
+					resource.TestCheckResourceAttr(testAccAppBLBIpGroupResourceName, "name", testAccAppBLBIpGroupResourceAttrName+"Update"),
+					resource.TestCheckResourceAttr(testAccAppBLBIpGroupResourceName, "description", "acceptance test update"),
+					resource.TestCheckResourceAttr(testAccAppBLBIpGroupResourceName, "backend_policy_list.#", "1"),
+					resource.TestCheckResourceAttr(testAccAppBLBIpGroupResourceName, "backend_policy_list.0.type", "HTTP"),
+					resource.TestCheckResourceAttr(testAccAppBLBIpGroupResourceName, "backend_policy_list.0.health_check_url_path", "/health"),
+					resource.TestCheckResourceAttr("baiducloud_appblb_listener.default", "policies.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAppBLBIpGroupDestory(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.BaiduClient)
+	appblbService := APPBLBService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccAppBLBIpGroupResourceType {
+			continue
+		}
+
+		_, err := appblbService.AppIpGroupDetail(rs.Primary.Attributes["blb_id"], rs.Primary.ID)
+		if err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return WrapError(err)
+		}
+
+		return WrapError(Error("APPBLB IpGroup still exist"))
+	}
+
+	return nil
+}
+
+func testAccAppBLBIpGroupConfigBase() string {
+	return fmt.Sprintf(`
+data "baiducloud_zones" "default" {}
+
+resource "baiducloud_vpc" "default" {
+  name        = "%s"
+  description = "test"
+  cidr        = "192.168.0.0/24"
+}
+
+resource "baiducloud_subnet" "default" {
+  name        = "%s"
+  zone_name   = data.baiducloud_zones.default.zones.0.zone_name
+  cidr        = "192.168.0.0/24"
+  vpc_id      = baiducloud_vpc.default.id
+  description = "test description"
+}
+
+resource "baiducloud_appblb" "default" {
+  name        = "%s"
+  description = ""
+  vpc_id      = baiducloud_vpc.default.id
+  subnet_id   = baiducloud_subnet.default.id
+}
+`, BaiduCloudTestResourceAttrNamePrefix+"VPC",
+		BaiduCloudTestResourceAttrNamePrefix+"Subnet",
+		BaiduCloudTestResourceAttrNamePrefix+"APPBLB")
+}
+
+func testAccAppBLBIpGroupConfig() string {
+	return testAccAppBLBIpGroupConfigBase() + fmt.Sprintf(`
+resource "%s" "%s" {
+  name        = "%s"
+  description = "acceptance test"
+  blb_id      = baiducloud_appblb.default.id
+
+  backend_policy_list {
+    type              = "TCP"
+    health_check_port = 80
+  }
+}
+`, testAccAppBLBIpGroupResourceType,
+		BaiduCloudTestResourceName,
+		testAccAppBLBIpGroupResourceAttrName)
+}
+
+func testAccAppBLBIpGroupConfigUpdate() string {
+	return testAccAppBLBIpGroupConfigBase() + fmt.Sprintf(`
+resource "%s" "%s" {
+  name        = "%s"
+  description = "acceptance test update"
+  blb_id      = baiducloud_appblb.default.id
+
+  backend_policy_list {
+    type                  = "HTTP"
+    health_check_url_path = "/health"
+  }
+}
+
+resource "baiducloud_appblb_listener" "default" {
+  blb_id        = baiducloud_appblb.default.id
+  listener_port = 80
+  protocol      = "HTTP"
+  scheduler     = "RoundRobin"
+
+  policies {
+    description     = "acceptance test"
+    app_ip_group_id = %s.id
+    priority        = 50
+
+    rule_list {
+      key   = "*"
+      value = "*"
+    }
+  }
+}
+`, testAccAppBLBIpGroupResourceType,
+		BaiduCloudTestResourceName,
+		testAccAppBLBIpGroupResourceAttrName+"Update",
+		testAccAppBLBIpGroupResourceName)
+}
@@ -55,6 +55,25 @@ resource "baiducloud_appblb_listener" "default" {
   encryption_protocols = ["sslv3", "tlsv10", "tlsv11"]
   encryption_type      = "userDefind"
 }
+
+[HTTP] Listener with IP Group
+resource "baiducloud_appblb_listener" "default" {
+  blb_id        = "lb-0d29a3f6"
+  listener_port = 132
+  protocol      = "HTTP"
+  scheduler     = "RoundRobin"
+
+  policies {
+    description     = "forward to idc"
+    app_ip_group_id = "ip_group-a1b2c3d4"
+    priority        = 50
+
+    rule_list {
+      key   = "*"
+      value = "*"
+    }
+  }
+}
 ```
 */
 package baiducloud
@@ -269,7 +288,7 @@ func resourceBaiduCloudAppBlbListener() *schema.Resource {
 			},
 			"policies": {
 				Type:        schema.TypeSet,
-				Description: "Listener's policy",
+				Description: "Listener's policy. Only the policies declared here are managed, policies managed by baiducloud_appblb_listener_policy or created outside are neither read nor deleted",
 				Optional:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -290,14 +309,24 @@ func resourceBaiduCloudAppBlbListener() *schema.Resource {
 						},
 						"app_server_group_id": {
 							Type:        schema.TypeString,
-							Description: "Policy bind server group id",
-							Required:    true,
+							Description: "Policy bind server group id, conflict with app_ip_group_id",
+							Optional:    true,
 						},
 						"app_server_group_name": {
 							Type:        schema.TypeString,
 							Description: "Policy bind server group name",
 							Computed:    true,
 						},
+						"app_ip_group_id": {
+							Type:        schema.TypeString,
+							Description: "Policy bind ip group id, conflict with app_server_group_id",
+							Optional:    true,
+						},
+						"app_ip_group_name": {
+							Type:        schema.TypeString,
+							Description: "Policy bind ip group name",
+							Computed:    true,
+						},
 						"frontend_port": {
 							Type:        schema.TypeInt,
 							Description: "Frontend port",
@@ -305,8 +334,8 @@ func resourceBaiduCloudAppBlbListener() *schema.Resource {
 						},
 						"backend_port": {
 							Type:         schema.TypeInt,
-							Description:  "Backend port",
-							Required:     true,
+							Description:  "Backend port, required when bind app_server_group_id",
+							Optional:     true,
 							ValidateFunc: validatePort(),
 						},
 						"priority": {
@@ -326,7 +355,7 @@ func resourceBaiduCloudAppBlbListener() *schema.Resource {
 										Type:         schema.TypeString,
 										Description:  "Rule key",
 										Required:     true,
-										ValidateFunc: validation.StringInSlice([]string{"*", "host", "uri"}, false),
+										ValidateFunc: validation.StringInSlice(AppBlbPolicyRuleKeys, false),
 									},
 									"value": {
 										Type:        schema.TypeString,
@@ -482,7 +511,20 @@ func resourceBaiduCloudAppBlbListenerRead(d *schema.ResourceData, meta interface
 		return WrapError(err)
 	}
 
-	if err := d.Set("policies", appblbService.FlattenAppPolicysToMap(policies)); err != nil {
+	// only track the policies declared by this resource, so that policies managed by
+	// baiducloud_appblb_listener_policy are never read into state and never deleted by an update
+	priorities := make(map[int]bool)
+	for _, p := range d.Get("policies").(*schema.Set).List() {
+		priorities[p.(map[string]interface{})["priority"].(int)] = true
+	}
+	managed := make([]appblb.AppPolicy, 0, len(priorities))
+	for _, policy := range policies {
+		if priorities[policy.Priority] {
+			managed = append(managed, policy)
+		}
+	}
+
+	if err := d.Set("policies", appblbService.FlattenAppPolicysToMap(managed)); err != nil {
 		return WrapError(err)
 	}
 
@@ -541,16 +583,17 @@ func resourceBaiduCloudAppBlbListenerUpdate(d *schema.ResourceData, meta interfa
 			return WrapError(err)
 		}
 
-		if len(remove) > 0 {
-			deleteArgs := &appblb.DeletePolicysArgs{
-				Port: uint16(listenerPort),
-			}
-
-			for _, p := range remove {
-				id := p.(map[string]interface{})["id"].(string)
+		// the old set only holds the policies written by this resource, see Read
+		deleteArgs := &appblb.DeletePolicysArgs{
+			Port: uint16(listenerPort),
+		}
+		for _, p := range remove {
+			if id := p.(map[string]interface{})["id"].(string); id != "" {
 				deleteArgs.PolicyIdList = append(deleteArgs.PolicyIdList, id)
 			}
+		}
 
+		if len(deleteArgs.PolicyIdList) > 0 {
 			_, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
 				return nil, client.DeletePolicys(blbId, deleteArgs)
 			})
@@ -1110,42 +1153,70 @@ func buildBaiduCloudCreatePolicyArgs(listenerPort int, protocol string, policys
 	}
 
 	for _, p := range policys {
-		pMap := p.(map[string]interface{})
-
-		policy := &appblb.AppPolicy{
-			AppServerGroupId: pMap["app_server_group_id"].(string),
-			BackendPort:      uint16(pMap["backend_port"].(int)),
-			Priority:         pMap["priority"].(int),
+		policy, err := buildBaiduCloudAppPolicy(protocol, p.(map[string]interface{}))
+		if err != nil {
+			return nil, err
 		}
 
-		if v, ok := pMap["description"]; ok && v.(string) != "" {
-			policy.Description = v.(string)
+		result.AppPolicyVos = append(result.AppPolicyVos, *policy)
+	}
+
+	return result, nil
+}
+
+func buildBaiduCloudAppPolicy(protocol string, pMap map[string]interface{}) (*appblb.AppPolicy, error) {
+	transportProtocol := stringInSlice(TransportProtocol, protocol)
+
+	policy := &appblb.AppPolicy{
+		AppServerGroupId: pMap["app_server_group_id"].(string),
+		AppIpGroupId:     pMap["app_ip_group_id"].(string),
+		BackendPort:      uint16(pMap["backend_port"].(int)),
+		Priority:         pMap["priority"].(int),
+	}
+
+	switch {
+	case policy.AppServerGroupId != "" && policy.AppIpGroupId != "":
+		return nil, fmt.Errorf("policy can only bind one of app_server_group_id and app_ip_group_id, but both are set")
+	case policy.AppIpGroupId != "":
+		// ip group members carry their own port
+		if policy.BackendPort != 0 {
+			return nil, fmt.Errorf("backend_port is not supported when policy bind app_ip_group_id")
+		}
+		policy.GroupType = AppIpGroupType
+	case policy.AppServerGroupId != "":
+		if policy.BackendPort == 0 {
+			return nil, fmt.Errorf("backend_port is required when policy bind app_server_group_id")
 		}
+		policy.GroupType = AppServerGroupType
+	default:
+		return nil, fmt.Errorf("policy must bind one of app_server_group_id and app_ip_group_id")
+	}
 
-		if r, ok := pMap["rule_list"]; ok && len(r.(*schema.Set).List()) > 0 {
-			rs := r.(*schema.Set).List()
+	if v, ok := pMap["description"]; ok && v.(string) != "" {
+		policy.Description = v.(string)
+	}
 
-			if transportProtocol && len(rs) > 1 {
-				return nil, fmt.Errorf("%s Listener only support one policy rule, but now is %d", protocol, len(rs))
-			}
+	if r, ok := pMap["rule_list"]; ok && len(r.(*schema.Set).List()) > 0 {
+		rs := r.(*schema.Set).List()
 
-			for _, r := range rs {
-				rMap := r.(map[string]interface{})
+		if transportProtocol && len(rs) > 1 {
+			return nil, fmt.Errorf("%s Listener only support one policy rule, but now is %d", protocol, len(rs))
+		}
 
-				rule := appblb.AppRule{}
-				rule.Key = rMap["key"].(string)
-				rule.Value = rMap["value"].(string)
+		for _, r := range rs {
+			rMap := r.(map[string]interface{})
 
-				if transportProtocol && (rule.Key != "*" || rule.Value != "*") {
-					return nil, fmt.Errorf("%s Listener only support one policy rule [key: *, value: *], but now is [key: %s, value: %s]", protocol, rule.Key, rule.Value)
-				}
+			rule := appblb.AppRule{}
+			rule.Key = rMap["key"].(string)
+			rule.Value = rMap["value"].(string)
 
-				policy.RuleList = append(policy.RuleList, rule)
+			if transportProtocol && (rule.Key != "*" || rule.Value != "*") {
+				return nil, fmt.Errorf("%s Listener only support one policy rule [key: *, value: *], but now is [key: %s, value: %s]", protocol, rule.Key, rule.Value)
 			}
-		}
 
-		result.AppPolicyVos = append(result.AppPolicyVos, *policy)
+			policy.RuleList = append(policy.RuleList, rule)
+		}
 	}
 
-	return result, nil
+	return policy, nil
 }
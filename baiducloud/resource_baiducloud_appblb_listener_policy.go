@@ -0,0 +1,364 @@
+/*
+Provide a resource to manage a single policy of an APPBLB Listener. The policy is identified by its priority in the listener,
+so policies of a shared listener can be managed separately. Don't set the same priority in `policies` of baiducloud_appblb_listener.
+
+Example Usage
+
+```hcl
+resource "baiducloud_appblb_listener_policy" "default" {
+  blb_id              = "lb-0d29a3f6"
+  listener_port       = 80
+  protocol            = "HTTP"
+  priority            = 10
+  description         = "route api requests"
+  app_server_group_id = "sg-11bd8054"
+  backend_port        = 8080
+
+  rule_list {
+    key   = "host"
+    value = "api.example.com"
+  }
+
+  rule_list {
+    key   = "uri"
+    value = "/v1/*"
+  }
+}
+```
+
+Import
+
+APPBLB Listener Policy can be imported by blb_id, protocol, listener_port and priority, e.g.
+
+```hcl
+$ terraform import baiducloud_appblb_listener_policy.default lb-0d29a3f6,HTTP,80,10
+```
+*/
+package baiducloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/services/appblb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudAppBlbListenerPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudAppBlbListenerPolicyCreate,
+		Read:   resourceBaiduCloudAppBlbListenerPolicyRead,
+		Update: resourceBaiduCloudAppBlbListenerPolicyUpdate,
+		Delete: resourceBaiduCloudAppBlbListenerPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"blb_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the Application LoadBalance instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"listener_port": {
+				Type:         schema.TypeInt,
+				Description:  "Port of the listener which the policy belongs to",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePort(),
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Description:  "Protocol of the listener which the policy belongs to, support TCP/UDP/HTTP/HTTPS/SSL",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{TCP, UDP, HTTP, HTTPS, SSL}, false),
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Description:  "Policy priority, support in [1, 32768], must be unique in the listener",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 32768),
+			},
+			"policy_id": {
+				Type:        schema.TypeString,
+				Description: "Policy's id, changes every time the policy is updated",
+				Computed:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Policy's description",
+				Optional:    true,
+			},
+			"app_server_group_id": {
+				Type:          schema.TypeString,
+				Description:   "Policy bind server group id",
+				Optional:      true,
+				ConflictsWith: []string{"app_ip_group_id"},
+			},
+			"app_server_group_name": {
+				Type:        schema.TypeString,
+				Description: "Policy bind server group name",
+				Computed:    true,
+			},
+			"app_ip_group_id": {
+				Type:          schema.TypeString,
+				Description:   "Policy bind ip group id",
+				Optional:      true,
+				ConflictsWith: []string{"app_server_group_id"},
+			},
+			"app_ip_group_name": {
+				Type:        schema.TypeString,
+				Description: "Policy bind ip group name",
+				Computed:    true,
+			},
+			"backend_port": {
+				Type:         schema.TypeInt,
+				Description:  "Backend port, required when bind app_server_group_id",
+				Optional:     true,
+				ValidateFunc: validatePort(),
+			},
+			"frontend_port": {
+				Type:        schema.TypeInt,
+				Description: "Frontend port",
+				Computed:    true,
+			},
+			"port_type": {
+				Type:        schema.TypeString,
+				Description: "Policy bind port protocol type",
+				Computed:    true,
+			},
+			"rule_list": {
+				Type:        schema.TypeSet,
+				Description: "Policy rule list, TCP/UDP/SSL listener only support one rule with key * and value *",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Description:  "Rule key, support */host/uri/query/header/cookie, uri matches the request path",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(AppBlbPolicyRuleKeys, false),
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "Rule value",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudAppBlbListenerPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	appblbService := APPBLBService{client}
+
+	blbId := d.Get("blb_id").(string)
+	protocol := d.Get("protocol").(string)
+	listenerPort := d.Get("listener_port").(int)
+	priority := d.Get("priority").(int)
+	action := fmt.Sprintf("Create APPBLB %s Listener [%s:%d] Policy %d", blbId, protocol, listenerPort, priority)
+
+	// priority is the identity of the policy, refuse to take over an existing one
+	if _, err := appblbService.AppPolicyDetail(blbId, protocol, listenerPort, priority); err == nil {
+		return WrapError(fmt.Errorf("policy with priority %d already exists in APPBLB %s Listener [%s:%d], please import it", priority, blbId, protocol, listenerPort))
+	} else if !NotFoundError(err) {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_listener_policy", action, BCESDKGoERROR)
+	}
+
+	if err := createAppBlbListenerPolicy(d, meta, schema.TimeoutCreate); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_listener_policy", action, BCESDKGoERROR)
+	}
+
+	d.SetId(strings.Join([]string{blbId, protocol, strconv.Itoa(listenerPort), strconv.Itoa(priority)}, COLON_SEPARATED))
+
+	return resourceBaiduCloudAppBlbListenerPolicyRead(d, meta)
+}
+
+func resourceBaiduCloudAppBlbListenerPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	appblbService := APPBLBService{client}
+
+	blbId, protocol, listenerPort, priority, err := parseAppBlbListenerPolicyId(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	action := fmt.Sprintf("Query APPBLB %s Listener [%s:%d] Policy %d", blbId, protocol, listenerPort, priority)
+
+	policy, err := appblbService.AppPolicyDetail(blbId, protocol, listenerPort, priority)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_listener_policy", action, BCESDKGoERROR)
+	}
+	addDebug(action, policy)
+
+	d.Set("blb_id", blbId)
+	d.Set("protocol", protocol)
+	d.Set("listener_port", listenerPort)
+	d.Set("priority", policy.Priority)
+	d.Set("policy_id", policy.Id)
+	d.Set("description", policy.Description)
+	d.Set("app_server_group_id", policy.AppServerGroupId)
+	d.Set("app_server_group_name", policy.AppServerGroupName)
+	d.Set("app_ip_group_id", policy.AppIpGroupId)
+	d.Set("app_ip_group_name", policy.AppIpGroupName)
+	d.Set("backend_port", policy.BackendPort)
+	d.Set("frontend_port", policy.FrontendPort)
+	d.Set("port_type", policy.PortType)
+
+	rules := make([]map[string]interface{}, 0, len(policy.RuleList))
+	for _, r := range policy.RuleList {
+		rules = append(rules, map[string]interface{}{
+			"key":   r.Key,
+			"value": r.Value,
+		})
+	}
+	if err := d.Set("rule_list", rules); err != nil {
+		return WrapError(err)
+	}
+
+	return nil
+}
+
+func resourceBaiduCloudAppBlbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	blbId := d.Get("blb_id").(string)
+	protocol := d.Get("protocol").(string)
+	listenerPort := d.Get("listener_port").(int)
+	priority := d.Get("priority").(int)
+	action := fmt.Sprintf("Update APPBLB %s Listener [%s:%d] Policy %d", blbId, protocol, listenerPort, priority)
+
+	// policy can't be modified, replace it with the same priority and leave
+	// other policies of the listener untouched
+	if err := deleteAppBlbListenerPolicy(d, meta, schema.TimeoutUpdate); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_listener_policy", action, BCESDKGoERROR)
+	}
+
+	if err := createAppBlbListenerPolicy(d, meta, schema.TimeoutUpdate); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_listener_policy", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudAppBlbListenerPolicyRead(d, meta)
+}
+
+func resourceBaiduCloudAppBlbListenerPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	blbId := d.Get("blb_id").(string)
+	protocol := d.Get("protocol").(string)
+	listenerPort := d.Get("listener_port").(int)
+	priority := d.Get("priority").(int)
+	action := fmt.Sprintf("Delete APPBLB %s Listener [%s:%d] Policy %d", blbId, protocol, listenerPort, priority)
+
+	if err := deleteAppBlbListenerPolicy(d, meta, schema.TimeoutDelete); err != nil {
+		if IsExceptedErrors(err, ObjectNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_listener_policy", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func createAppBlbListenerPolicy(d *schema.ResourceData, meta interface{}, timeoutKey string) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	blbId := d.Get("blb_id").(string)
+	protocol := d.Get("protocol").(string)
+	listenerPort := d.Get("listener_port").(int)
+
+	policy, err := buildBaiduCloudAppPolicy(protocol, map[string]interface{}{
+		"description":         d.Get("description"),
+		"app_server_group_id": d.Get("app_server_group_id"),
+		"app_ip_group_id":     d.Get("app_ip_group_id"),
+		"backend_port":        d.Get("backend_port"),
+		"priority":            d.Get("priority"),
+		"rule_list":           d.Get("rule_list"),
+	})
+	if err != nil {
+		return err
+	}
+	args := &appblb.CreatePolicysArgs{
+		ListenerPort: uint16(listenerPort),
+		AppPolicyVos: []appblb.AppPolicy{*policy},
+		ClientToken:  buildClientToken(),
+	}
+
+	return resource.Retry(d.Timeout(timeoutKey), func() *resource.RetryError {
+		_, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+			return nil, client.CreatePolicys(blbId, args)
+		})
+		addDebug("Create APPBLB "+blbId+" Listener Policy", args)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func deleteAppBlbListenerPolicy(d *schema.ResourceData, meta interface{}, timeoutKey string) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	blbId := d.Get("blb_id").(string)
+	args := &appblb.DeletePolicysArgs{
+		Port:         uint16(d.Get("listener_port").(int)),
+		PolicyIdList: []string{d.Get("policy_id").(string)},
+		ClientToken:  buildClientToken(),
+	}
+
+	return resource.Retry(d.Timeout(timeoutKey), func() *resource.RetryError {
+		_, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+			return nil, client.DeletePolicys(blbId, args)
+		})
+		addDebug("Delete APPBLB "+blbId+" Listener Policy", args)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func parseAppBlbListenerPolicyId(id string) (blbId, protocol string, listenerPort, priority int, err error) {
+	items := strings.Split(id, COLON_SEPARATED)
+	if len(items) != 4 {
+		return "", "", 0, 0, fmt.Errorf("invalid APPBLB Listener Policy id %s, should be blb_id,protocol,listener_port,priority", id)
+	}
+
+	if listenerPort, err = strconv.Atoi(items[2]); err != nil {
+		return "", "", 0, 0, fmt.Errorf("invalid listener_port in APPBLB Listener Policy id %s", id)
+	}
+	if priority, err = strconv.Atoi(items[3]); err != nil {
+		return "", "", 0, 0, fmt.Errorf("invalid priority in APPBLB Listener Policy id %s", id)
+	}
+
+	return items[0], items[1], listenerPort, priority, nil
+}
@@ -0,0 +1,181 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	testAccAppBLBListenerPolicyResourceType = "baiducloud_appblb_listener_policy"
+	testAccAppBLBListenerPolicyResourceName = testAccAppBLBListenerPolicyResourceType + "." + BaiduCloudTestResourceName
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudAppBLBListenerPolicy_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAppBLBListenerPolicyDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBLBListenerPolicyConfig("baidu.com", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccAppBLBListenerPolicyResourceName),
+					resource.TestCheckResourceAttr(testAccAppBLBListenerPolicyResourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(testAccAppBLBListenerPolicyResourceName, "rule_list.#", "2"),
+					resource.TestCheckResourceAttrSet(testAccAppBLBListenerPolicyResourceName, "policy_id"),
+					resource.TestCheckResourceAttrSet(testAccAppBLBListenerPolicyResourceName, "app_server_group_id"),
+					resource.TestCheckResourceAttr("baiducloud_appblb_listener.default", "policies.#", "0"),
+				),
+			},
+			{
+				ResourceName:      testAccAppBLBListenerPolicyResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// adding policies to the listener must keep the policy managed by the standalone resource
+				Config: testAccAppBLBListenerPolicyConfig("baidu.com", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBLBListenerPolicyExists(testAccAppBLBListenerPolicyResourceName),
+					resource.TestCheckResourceAttr(testAccAppBLBListenerPolicyResourceName, "priority", "10"),
+					resource.TestCheckResourceAttr("baiducloud_appblb_listener.default", "policies.#", "1"),
+					resource.TestCheckResourceAttr("baiducloud_appblb_listener.default", "policies.0.priority", "100"),
+				),
+			},
+			{
+				Config:   testAccAppBLBListenerPolicyConfig("baidu.com", true),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAppBLBListenerPolicyConfig("baidunew.com", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBLBListenerPolicyExists(testAccAppBLBListenerPolicyResourceName),
+					resource.TestCheckResourceAttr(testAccAppBLBListenerPolicyResourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(testAccAppBLBListenerPolicyResourceName, "rule_list.#", "2"),
+					resource.TestCheckResourceAttr("baiducloud_appblb_listener.default", "policies.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAppBLBListenerPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("can't find appblb listener policy: %s", name)
+		}
+		client := testAccProvider.Meta().(*connectivity.BaiduClient)
+		appblbService := APPBLBService{client}
+
+		blbId, protocol, listenerPort, priority, err := parseAppBlbListenerPolicyId(rs.Primary.ID)
+		if err != nil {
+			return WrapError(err)
+		}
+		if _, err := appblbService.AppPolicyDetail(blbId, protocol, listenerPort, priority); err != nil {
+			return WrapError(err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAppBLBListenerPolicyDestory(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.BaiduClient)
+	appblbService := APPBLBService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccAppBLBListenerPolicyResourceType {
+			continue
+		}
+
+		blbId, protocol, listenerPort, priority, err := parseAppBlbListenerPolicyId(rs.Primary.ID)
+		if err != nil {
+			return WrapError(err)
+		}
+
+		_, err = appblbService.AppPolicyDetail(blbId, protocol, listenerPort, priority)
+		if err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return WrapError(err)
+		}
+
+		return WrapError(Error("APPBLB Listener Policy still exist"))
+	}
+
+	return nil
+}
+
+func testAccAppBLBListenerPolicyConfig(host string, listenerPolicies bool) string {
+	policies := ""
+	if listenerPolicies {
+		policies = `
+  policies {
+    description         = "default route"
+    app_server_group_id = baiducloud_appblb_server_group.default.id
+    backend_port        = 80
+    priority            = 100
+
+    rule_list {
+      key   = "*"
+      value = "*"
+    }
+  }
+`
+	}
+
+	return testAccAppBLBIpGroupConfigBase() + fmt.Sprintf(`
+resource "baiducloud_appblb_server_group" "default" {
+  name   = "%s"
+  blb_id = baiducloud_appblb.default.id
+
+  port_list {
+    port         = 80
+    type         = "HTTP"
+    health_check = "HTTP"
+  }
+}
+
+resource "baiducloud_appblb_listener" "default" {
+  blb_id        = baiducloud_appblb.default.id
+  listener_port = 80
+  protocol      = "HTTP"
+  scheduler     = "RoundRobin"
+%s}
+
+resource "%s" "%s" {
+  blb_id              = baiducloud_appblb.default.id
+  listener_port       = baiducloud_appblb_listener.default.listener_port
+  protocol            = baiducloud_appblb_listener.default.protocol
+  priority            = 10
+  description         = "acceptance test"
+  app_server_group_id = baiducloud_appblb_server_group.default.id
+  backend_port        = 80
+
+  rule_list {
+    key   = "host"
+    value = "%s"
+  }
+
+  rule_list {
+    key   = "uri"
+    value = "/api/*"
+  }
+}
+`, BaiduCloudTestResourceAttrNamePrefix+"APPBLBServerGroup",
+		policies,
+		testAccAppBLBListenerPolicyResourceType,
+		BaiduCloudTestResourceName,
+		host)
+}
@@ -1,6 +1,11 @@
 /*
 Provide a resource to create an APPBLB Server Group.
 
+~> **NOTE:** backend_server_list and baiducloud_appblb_server_group_member are two conflicting ways to manage the backends
+of a server group. If backend_server_list is set, it owns all the backends of the server group and removes the ones registered
+by baiducloud_appblb_server_group_member. If backend_server_list is not set, the backends are not read into state at all,
+so backends added or removed outside of Terraform are not detected by this resource.
+
 Example Usage
 
 ```hcl
@@ -78,7 +83,7 @@ func resourceBaiduCloudAppBlbServerGroup() *schema.Resource {
 			},
 			"backend_server_list": {
 				Type:        schema.TypeSet,
-				Description: "Server group bound backend server list",
+				Description: "Server group bound backend server list. Changing a weight updates the backend in place, and only the added or removed backends are registered or deregistered. If not set, the backends of the server group are not tracked, so that they can be managed by baiducloud_appblb_server_group_member instead",
 				Optional:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -320,6 +325,11 @@ func resourceBaiduCloudAppBlbServerGroupRead(d *schema.ResourceData, meta interf
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appservergroup", action, BCESDKGoERROR)
 	}
 	addDebug(action, group)
+	// backends are only tracked when they are managed by backend_server_list,
+	// otherwise they are left to baiducloud_appblb_server_group_member, see the NOTE in the doc
+	if d.Get("backend_server_list").(*schema.Set).Len() == 0 {
+		return nil
+	}
 	if err := d.Set("backend_server_list", appblbService.FlattenAppBackendServersToMap(servers)); err != nil {
 		return WrapError(err)
 	}
@@ -330,17 +340,17 @@ func resourceBaiduCloudAppBlbServerGroupRead(d *schema.ResourceData, meta interf
 func resourceBaiduCloudAppBlbServerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	d.Partial(true)
 	if d.HasChange("port_list") {
-		d.SetPartial("port_list")
 		if err := updateAppServerGroupPortList(d, meta); err != nil {
 			return err
 		}
+		d.SetPartial("port_list")
 	}
 
 	if d.HasChange("backend_server_list") {
-		d.SetPartial("backend_server_list")
 		if err := updateAppServerGroupRs(d, meta); err != nil {
 			return err
 		}
+		d.SetPartial("backend_server_list")
 	}
 
 	d.Partial(false)
@@ -424,11 +434,16 @@ func updateAppServerGroupRs(d *schema.ResourceData, meta interface{}) error {
 	add := ns.Difference(os).List()
 
 	addArgs, updateArgs, removeArgs := buildAppServerGroupRsWriteOpArgs(add, remove)
-	if removeArgs != nil {
-		removeArgs.SgId = id
-		removeArgs.ClientToken = buildClientToken()
 
-		if err := appblbService.DeleteAppServerGroupRs(blbId, removeArgs); err != nil {
+	// register new backends and update weights before deregistering, so that traffic is not drained
+	if addArgs != nil {
+		addArgs.SgId = id
+		addArgs.ClientToken = buildClientToken()
+		args := &appblb.CreateBlbRsArgs{
+			BlbRsWriteOpArgs: *addArgs,
+		}
+
+		if err := appblbService.CreateAppServerGroupRs(blbId, args); err != nil {
 			return WrapError(err)
 		}
 
@@ -453,14 +468,11 @@ func updateAppServerGroupRs(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if addArgs != nil {
-		addArgs.SgId = id
-		addArgs.ClientToken = buildClientToken()
-		args := &appblb.CreateBlbRsArgs{
-			BlbRsWriteOpArgs: *addArgs,
-		}
+	if removeArgs != nil {
+		removeArgs.SgId = id
+		removeArgs.ClientToken = buildClientToken()
 
-		if err := appblbService.CreateAppServerGroupRs(blbId, args); err != nil {
+		if err := appblbService.DeleteAppServerGroupRs(blbId, removeArgs); err != nil {
 			return WrapError(err)
 		}
 
@@ -506,7 +518,8 @@ func buildAppServerGroupRsWriteOpArgs(addList, removeList []interface{}) (add, u
 	removePartList := make([]interface{}, 0)
 	for key, value := range addMap {
 		if v, ok := removeMap[key]; ok {
-			if !reflect.DeepEqual(value, v) {
+			// same backend with a different weight, update it in place
+			if value.(map[string]interface{})["weight"] != v.(map[string]interface{})["weight"] {
 				updatePartList = append(updatePartList, value)
 			}
 			delete(removeMap, key)
@@ -575,16 +588,32 @@ func updateAppServerGroupPortList(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	if deleteArgs != nil {
+	deletePorts := func() error {
+		if deleteArgs == nil {
+			return nil
+		}
+
 		deleteArgs.SgId = id
 		deleteArgs.ClientToken = buildClientToken()
 
 		if err := appblbService.DeleteAppServerGroupPort(blbId, deleteArgs); err != nil {
 			return WrapError(err)
 		}
+		deleteArgs = nil
 
-		if err := appblbService.WaitForServerGroupUpdateFinish(d); err != nil {
-			return WrapError(err)
+		return appblbService.WaitForServerGroupUpdateFinish(d)
+	}
+
+	// create new ports and update changed ports before deleting the removed ones,
+	// unless a new port reuses the port number of a removed one
+	for _, args := range addArgs {
+		for _, v := range remove {
+			if v.(map[string]interface{})["port"].(int) == int(args.Port) {
+				if err := deletePorts(); err != nil {
+					return err
+				}
+				break
+			}
 		}
 	}
 
@@ -614,7 +643,7 @@ func updateAppServerGroupPortList(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	return nil
+	return deletePorts()
 }
 
 func buildBaiduCloudCreateAppBlbAppServerGroupPortArgs(addList, removeList []interface{}) (
@@ -865,10 +894,24 @@ func portListConfigDifference(rootList []interface{}, compareList []interface{})
 		rMap := root.(map[string]interface{})
 		key := fmt.Sprintf("%v_%v", rMap["port"], rMap["type"])
 
-		if compare, ok := compareMap[key]; !ok || !reflect.DeepEqual(root, compare) {
+		if compare, ok := compareMap[key]; !ok || !reflect.DeepEqual(portListConfigFields(root), portListConfigFields(compare)) {
 			result = append(result, root)
 		}
 	}
 
 	return result
 }
+
+// portListConfigFields drops the computed fields of a port, which may be moved between
+// list elements when the list is reordered and should not cause an update
+func portListConfigFields(port interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, v := range port.(map[string]interface{}) {
+		if k == "id" || k == "status" {
+			continue
+		}
+		result[k] = v
+	}
+
+	return result
+}
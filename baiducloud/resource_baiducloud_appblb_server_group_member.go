@@ -0,0 +1,245 @@
+/*
+Provide a resource to register a backend server to an APPBLB Server Group. It allows backends to be managed independently,
+such as by autoscaling modules.
+
+~> **NOTE:** This resource conflicts with backend_server_list of baiducloud_appblb_server_group. Leave backend_server_list
+unset on the server group, otherwise the backends registered by this resource are removed on the next apply of the server group.
+
+Example Usage
+
+```hcl
+resource "baiducloud_appblb_server_group_member" "default" {
+  blb_id          = "lb-0d29a3f6"
+  server_group_id = "sg-5b9b7d9e"
+  instance_id     = "i-tgZhS50C"
+  weight          = 50
+}
+```
+
+Import
+
+APPBLB Server Group Member can be imported by blb_id, server_group_id and instance_id, e.g.
+
+```hcl
+$ terraform import baiducloud_appblb_server_group_member.default lb-0d29a3f6,sg-5b9b7d9e,i-tgZhS50C
+```
+*/
+package baiducloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/services/appblb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudAppBlbServerGroupMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudAppBlbServerGroupMemberCreate,
+		Read:   resourceBaiduCloudAppBlbServerGroupMemberRead,
+		Update: resourceBaiduCloudAppBlbServerGroupMemberUpdate,
+		Delete: resourceBaiduCloudAppBlbServerGroupMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"blb_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the Application LoadBalance instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"server_group_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the Server Group",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "Backend server instance ID",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Description:  "Backend server instance weight in this group, range from 0-100",
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"private_ip": {
+				Type:        schema.TypeString,
+				Description: "Backend server instance bind private ip",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudAppBlbServerGroupMemberCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	appblbService := APPBLBService{client}
+
+	blbId := d.Get("blb_id").(string)
+	sgId := d.Get("server_group_id").(string)
+	instanceId := d.Get("instance_id").(string)
+	args := &appblb.CreateBlbRsArgs{
+		BlbRsWriteOpArgs: appblb.BlbRsWriteOpArgs{
+			SgId: sgId,
+			BackendServerList: []appblb.AppBackendServer{{
+				InstanceId: instanceId,
+				Weight:     d.Get("weight").(int),
+			}},
+			ClientToken: buildClientToken(),
+		},
+	}
+	action := "Create APPBLB " + blbId + " App Server Group " + sgId + " Member " + instanceId
+
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+			return nil, client.CreateBlbRs(blbId, args)
+		})
+		addDebug(action, args)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_server_group_member", action, BCESDKGoERROR)
+	}
+
+	d.SetId(strings.Join([]string{blbId, sgId, instanceId}, COLON_SEPARATED))
+
+	if err := appblbService.WaitForServerGroupAvailable(blbId, sgId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_server_group_member", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudAppBlbServerGroupMemberRead(d, meta)
+}
+
+func resourceBaiduCloudAppBlbServerGroupMemberRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	appblbService := APPBLBService{client}
+
+	items := strings.Split(d.Id(), COLON_SEPARATED)
+	if len(items) != 3 {
+		return WrapError(fmt.Errorf("invalid APPBLB Server Group Member id %s, should be blb_id,server_group_id,instance_id", d.Id()))
+	}
+	blbId, sgId, instanceId := items[0], items[1], items[2]
+	action := "Query APPBLB " + blbId + " App Server Group " + sgId + " Member " + instanceId
+
+	server, err := appblbService.AppServerGroupRsDetail(blbId, sgId, instanceId)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_server_group_member", action, BCESDKGoERROR)
+	}
+	addDebug(action, server)
+
+	d.Set("blb_id", blbId)
+	d.Set("server_group_id", sgId)
+	d.Set("instance_id", server.InstanceId)
+	d.Set("weight", server.Weight)
+	d.Set("private_ip", server.PrivateIp)
+
+	return nil
+}
+
+func resourceBaiduCloudAppBlbServerGroupMemberUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	appblbService := APPBLBService{client}
+
+	blbId := d.Get("blb_id").(string)
+	sgId := d.Get("server_group_id").(string)
+	instanceId := d.Get("instance_id").(string)
+	action := "Update APPBLB " + blbId + " App Server Group " + sgId + " Member " + instanceId
+
+	if d.HasChange("weight") {
+		args := &appblb.UpdateBlbRsArgs{
+			BlbRsWriteOpArgs: appblb.BlbRsWriteOpArgs{
+				SgId: sgId,
+				BackendServerList: []appblb.AppBackendServer{{
+					InstanceId: instanceId,
+					Weight:     d.Get("weight").(int),
+				}},
+				ClientToken: buildClientToken(),
+			},
+		}
+
+		_, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+			return nil, client.UpdateBlbRs(blbId, args)
+		})
+		addDebug(action, args)
+
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_server_group_member", action, BCESDKGoERROR)
+		}
+
+		if err := appblbService.WaitForServerGroupAvailable(blbId, sgId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_server_group_member", action, BCESDKGoERROR)
+		}
+	}
+
+	return resourceBaiduCloudAppBlbServerGroupMemberRead(d, meta)
+}
+
+func resourceBaiduCloudAppBlbServerGroupMemberDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	blbId := d.Get("blb_id").(string)
+	sgId := d.Get("server_group_id").(string)
+	instanceId := d.Get("instance_id").(string)
+	args := &appblb.DeleteBlbRsArgs{
+		SgId:                sgId,
+		BackendServerIdList: []string{instanceId},
+		ClientToken:         buildClientToken(),
+	}
+	action := "Delete APPBLB " + blbId + " App Server Group " + sgId + " Member " + instanceId
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+			return nil, client.DeleteBlbRs(blbId, args)
+		})
+		addDebug(action, args)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if IsExceptedErrors(err, ObjectNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_server_group_member", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	testAccAppBLBServerGroupMemberResourceType = "baiducloud_appblb_server_group_member"
+	testAccAppBLBServerGroupMemberResourceName = testAccAppBLBServerGroupMemberResourceType + "." + BaiduCloudTestResourceName
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudAppBLBServerGroupMember_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAppBLBServerGroupMemberDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBLBServerGroupMemberConfig(50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccAppBLBServerGroupMemberResourceName),
+					resource.TestCheckResourceAttr(testAccAppBLBServerGroupMemberResourceName, "weight", "50"),
+					resource.TestCheckResourceAttrSet(testAccAppBLBServerGroupMemberResourceName, "private_ip"),
+					resource.TestCheckResourceAttr(testAccAppBLBServerGroupResourceName, "backend_server_list.#", "0"),
+				),
+			},
+			{
+				ResourceName:      testAccAppBLBServerGroupMemberResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppBLBServerGroupMemberConfig(80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccAppBLBServerGroupMemberResourceName),
+					resource.TestCheckResourceAttr(testAccAppBLBServerGroupMemberResourceName, "weight", "80"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAppBLBServerGroupMemberDestory(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.BaiduClient)
+	appblbService := APPBLBService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccAppBLBServerGroupMemberResourceType {
+			continue
+		}
+
+		_, err := appblbService.AppServerGroupRsDetail(rs.Primary.Attributes["blb_id"],
+			rs.Primary.Attributes["server_group_id"], rs.Primary.Attributes["instance_id"])
+		if err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return WrapError(err)
+		}
+
+		return WrapError(Error("APPBLB Server Group Member still exist"))
+	}
+
+	return nil
+}
+
+func testAccAppBLBServerGroupMemberConfig(weight int) string {
+	return testAccAppBLBServerGroupConfig() + fmt.Sprintf(`
+resource "%s" "%s" {
+  blb_id          = baiducloud_appblb.default.id
+  server_group_id = %s.%s.id
+  instance_id     = baiducloud_instance.default.id
+  weight          = %d
+}
+`, testAccAppBLBServerGroupMemberResourceType, BaiduCloudTestResourceName,
+		testAccAppBLBServerGroupResourceType, BaiduCloudTestResourceName, weight)
+}
@@ -250,3 +250,87 @@ resource "baiducloud_eip_association" "default" {
 		testAccAppBLBResourceType, BaiduCloudTestResourceName, testAccAppBLBResourceAttrName+"Update",
 		testAccAppBLBResourceType, BaiduCloudTestResourceName)
 }
+
+//lintignore:AT003
+func TestAccBaiduCloudAppBLB_Eip(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAppBLBDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBLBEipConfig("baiducloud_eip.default.eip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccAppBLBResourceName),
+					resource.TestCheckResourceAttrPair(testAccAppBLBResourceName, "eip", "baiducloud_eip.default", "eip"),
+					resource.TestCheckResourceAttrPair(testAccAppBLBResourceName, "public_ip", "baiducloud_eip.default", "eip"),
+				),
+			},
+			{
+				Config: testAccAppBLBEipConfig("baiducloud_eip.update.eip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccAppBLBResourceName),
+					resource.TestCheckResourceAttrPair(testAccAppBLBResourceName, "eip", "baiducloud_eip.update", "eip"),
+					resource.TestCheckResourceAttrPair(testAccAppBLBResourceName, "public_ip", "baiducloud_eip.update", "eip"),
+				),
+			},
+			{
+				Config: testAccAppBLBEipConfig(`""`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccAppBLBResourceName),
+					resource.TestCheckResourceAttr(testAccAppBLBResourceName, "eip", ""),
+					resource.TestCheckResourceAttr(testAccAppBLBResourceName, "public_ip", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccAppBLBEipConfig(eip string) string {
+	return fmt.Sprintf(`
+data "baiducloud_zones" "default" {}
+
+resource "baiducloud_vpc" "default" {
+  name        = "%s"
+  description = "test"
+  cidr        = "192.168.0.0/24"
+}
+
+resource "baiducloud_subnet" "default" {
+  name        = "%s"
+  zone_name   = data.baiducloud_zones.default.zones.0.zone_name
+  cidr        = "192.168.0.0/24"
+  vpc_id      = baiducloud_vpc.default.id
+  description = "test description"
+}
+
+resource "baiducloud_eip" "default" {
+  name              = "%s"
+  bandwidth_in_mbps = 1
+  payment_timing    = "Postpaid"
+  billing_method    = "ByTraffic"
+}
+
+resource "baiducloud_eip" "update" {
+  name              = "%s"
+  bandwidth_in_mbps = 1
+  payment_timing    = "Postpaid"
+  billing_method    = "ByTraffic"
+}
+
+resource "%s" "%s" {
+  name        = "%s"
+  description = ""
+  vpc_id      = baiducloud_vpc.default.id
+  subnet_id   = baiducloud_subnet.default.id
+  eip         = %s
+}
+`, BaiduCloudTestResourceAttrNamePrefix+"VPC",
+		BaiduCloudTestResourceAttrNamePrefix+"Subnet",
+		BaiduCloudTestResourceAttrNamePrefix+"EIP",
+		BaiduCloudTestResourceAttrNamePrefix+"EIPUpdate",
+		testAccAppBLBResourceType, BaiduCloudTestResourceName, testAccAppBLBResourceAttrName, eip)
+}
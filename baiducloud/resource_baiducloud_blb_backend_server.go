@@ -0,0 +1,212 @@
+/*
+Provide a resource to attach a backend server to a classic BLB.
+
+Example Usage
+
+```hcl
+resource "baiducloud_blb_backend_server" "default" {
+  blb_id      = "lb-0d29a3f6"
+  instance_id = "i-tgZhS50C"
+  weight      = 50
+}
+```
+
+Import
+
+BLB Backend Server can be imported by blb_id and instance_id, e.g.
+
+```hcl
+$ terraform import baiducloud_blb_backend_server.default lb-0d29a3f6,i-tgZhS50C
+```
+*/
+package baiducloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/services/blb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudBlbBackendServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudBlbBackendServerCreate,
+		Read:   resourceBaiduCloudBlbBackendServerRead,
+		Update: resourceBaiduCloudBlbBackendServerUpdate,
+		Delete: resourceBaiduCloudBlbBackendServerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"blb_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the LoadBalance instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the backend server instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Description:  "Weight of the backend server, range from 0-100",
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"private_ip": {
+				Type:        schema.TypeString,
+				Description: "Private ip of the backend server",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudBlbBackendServerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	blbId := d.Get("blb_id").(string)
+	instanceId := d.Get("instance_id").(string)
+	args := &blb.AddBackendServersArgs{
+		ClientToken: buildClientToken(),
+		BackendServerList: []blb.BackendServerModel{{
+			InstanceId: instanceId,
+			Weight:     d.Get("weight").(int),
+		}},
+	}
+	action := "Add BLB " + blbId + " Backend Server " + instanceId
+
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err := client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+			return nil, client.AddBackendServers(blbId, args)
+		})
+		addDebug(action, args)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_blb_backend_server", action, BCESDKGoERROR)
+	}
+
+	d.SetId(strings.Join([]string{blbId, instanceId}, COLON_SEPARATED))
+
+	return resourceBaiduCloudBlbBackendServerRead(d, meta)
+}
+
+func resourceBaiduCloudBlbBackendServerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	blbService := BLBService{client}
+
+	items := strings.Split(d.Id(), COLON_SEPARATED)
+	if len(items) != 2 {
+		return WrapError(fmt.Errorf("invalid BLB Backend Server id %s, should be blb_id,instance_id", d.Id()))
+	}
+	blbId, instanceId := items[0], items[1]
+	action := "Query BLB " + blbId + " Backend Server " + instanceId
+
+	server, err := blbService.BackendServerDetail(blbId, instanceId)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_blb_backend_server", action, BCESDKGoERROR)
+	}
+	addDebug(action, server)
+
+	d.Set("blb_id", blbId)
+	d.Set("instance_id", server.InstanceId)
+	d.Set("weight", server.Weight)
+	d.Set("private_ip", server.PrivateIp)
+
+	return nil
+}
+
+func resourceBaiduCloudBlbBackendServerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	blbId := d.Get("blb_id").(string)
+	instanceId := d.Get("instance_id").(string)
+	action := "Update BLB " + blbId + " Backend Server " + instanceId
+
+	if d.HasChange("weight") {
+		args := &blb.UpdateBackendServersArgs{
+			ClientToken: buildClientToken(),
+			BackendServerList: []blb.BackendServerModel{{
+				InstanceId: instanceId,
+				Weight:     d.Get("weight").(int),
+			}},
+		}
+
+		_, err := client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+			return nil, client.UpdateBackendServers(blbId, args)
+		})
+		addDebug(action, args)
+
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_blb_backend_server", action, BCESDKGoERROR)
+		}
+	}
+
+	return resourceBaiduCloudBlbBackendServerRead(d, meta)
+}
+
+func resourceBaiduCloudBlbBackendServerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	blbId := d.Get("blb_id").(string)
+	instanceId := d.Get("instance_id").(string)
+	args := &blb.RemoveBackendServersArgs{
+		ClientToken:       buildClientToken(),
+		BackendServerList: []string{instanceId},
+	}
+	action := "Remove BLB " + blbId + " Backend Server " + instanceId
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+			return nil, client.RemoveBackendServers(blbId, args)
+		})
+		addDebug(action, args)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if IsExceptedErrors(err, ObjectNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_blb_backend_server", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
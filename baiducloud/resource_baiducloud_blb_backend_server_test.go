@@ -0,0 +1,84 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	testAccBLBBackendServerResourceType = "baiducloud_blb_backend_server"
+	testAccBLBBackendServerResourceName = testAccBLBBackendServerResourceType + "." + BaiduCloudTestResourceName
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBLBBackendServer(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBLBBackendServerDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBLBBackendServerConfig(50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBLBBackendServerResourceName),
+					resource.TestCheckResourceAttr(testAccBLBBackendServerResourceName, "weight", "50"),
+					resource.TestCheckResourceAttrSet(testAccBLBBackendServerResourceName, "private_ip"),
+				),
+			},
+			{
+				ResourceName:      testAccBLBBackendServerResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBLBBackendServerConfig(80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBLBBackendServerResourceName),
+					resource.TestCheckResourceAttr(testAccBLBBackendServerResourceName, "weight", "80"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBLBBackendServerDestory(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.BaiduClient)
+	blbService := BLBService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccBLBBackendServerResourceType {
+			continue
+		}
+
+		_, err := blbService.BackendServerDetail(rs.Primary.Attributes["blb_id"], rs.Primary.Attributes["instance_id"])
+		if err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return WrapError(err)
+		}
+
+		return WrapError(Error("BLB Backend Server still exist"))
+	}
+
+	return nil
+}
+
+func testAccBLBBackendServerConfig(weight int) string {
+	return testAccBLBConfig(testAccBLBResourceAttrName, "") + fmt.Sprintf(`
+resource "%s" "%s" {
+  blb_id      = %s.%s.id
+  instance_id = baiducloud_instance.default.id
+  weight      = %d
+}
+`, testAccBLBBackendServerResourceType, BaiduCloudTestResourceName,
+		testAccBLBResourceType, BaiduCloudTestResourceName, weight)
+}
@@ -0,0 +1,796 @@
+/*
+Provide a resource to create a classic BLB Listener.
+
+Example Usage
+
+```hcl
+[TCP/UDP] Listener
+resource "baiducloud_blb_listener" "default" {
+  blb_id        = "lb-0d29a3f6"
+  listener_port = 124
+  backend_port  = 8080
+  protocol      = "TCP"
+  scheduler     = "LeastConnection"
+}
+
+[HTTP] Listener
+resource "baiducloud_blb_listener" "default" {
+  blb_id                = "lb-0d29a3f6"
+  listener_port         = 129
+  backend_port          = 8080
+  protocol              = "HTTP"
+  scheduler             = "RoundRobin"
+  keep_session          = true
+  health_check_uri      = "/health"
+}
+
+[HTTPS] Listener
+resource "baiducloud_blb_listener" "default" {
+  blb_id               = "lb-0d29a3f6"
+  listener_port        = 130
+  backend_port         = 8080
+  protocol             = "HTTPS"
+  scheduler            = "LeastConnection"
+  cert_ids             = ["cert-xvysj80uif1y"]
+  encryption_protocols = ["tlsv10", "tlsv11", "tlsv12"]
+  encryption_type      = "userDefind"
+}
+```
+
+Import
+
+BLB Listener can be imported by blb_id, protocol and listener_port, e.g.
+
+```hcl
+$ terraform import baiducloud_blb_listener.default lb-0d29a3f6,TCP,124
+```
+*/
+package baiducloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/services/blb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudBlbListener() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudBlbListenerCreate,
+		Read:   resourceBaiduCloudBlbListenerRead,
+		Update: resourceBaiduCloudBlbListenerUpdate,
+		Delete: resourceBaiduCloudBlbListenerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"blb_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the LoadBalance instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"listener_port": {
+				Type:         schema.TypeInt,
+				Description:  "Listening port, range from 1-65535",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePort(),
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Description:  "Listening protocol, support TCP/UDP/HTTP/HTTPS/SSL",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{TCP, UDP, HTTP, HTTPS, SSL}, false),
+			},
+			"backend_port": {
+				Type:         schema.TypeInt,
+				Description:  "Port of the backend servers, range from 1-65535",
+				Required:     true,
+				ValidateFunc: validatePort(),
+			},
+			"scheduler": {
+				Type:         schema.TypeString,
+				Description:  "Load balancing algorithm, support RoundRobin/LeastConnection/Hash, if protocol is HTTP/HTTPS, only support RoundRobin/LeastConnection",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"RoundRobin", "LeastConnection", "Hash"}, false),
+			},
+			"tcp_session_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "TCP Listener connection session timeout time(second), default 900, support 10-4000",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(10, 4000),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Get("protocol").(string) != TCP
+				},
+			},
+			"health_check_timeout_in_second": {
+				Type:         schema.TypeInt,
+				Description:  "Health check timeout(second), support in [1, 60], default 3",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			"health_check_interval": {
+				Type:         schema.TypeInt,
+				Description:  "Health check interval time(second), support in [1, 10], default 3",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"unhealthy_threshold": {
+				Type:         schema.TypeInt,
+				Description:  "Unhealthy threshold, the backend server is considered unhealthy after this number of failed health checks, support in [2, 5], default 3",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(2, 5),
+			},
+			"healthy_threshold": {
+				Type:         schema.TypeInt,
+				Description:  "Healthy threshold, the backend server is considered healthy after this number of successful health checks, support in [2, 5], default 3",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(2, 5),
+			},
+			// udp
+			"health_check_string": {
+				Type:        schema.TypeString,
+				Description: "UDP health check string, required when protocol is UDP",
+				Optional:    true,
+				Computed:    true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Get("protocol").(string) != UDP
+				},
+			},
+			// http & https
+			"keep_session": {
+				Type:             schema.TypeBool,
+				Description:      "KeepSession or not",
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: appBlbProtocolTCPUDPSSLSuppressFunc,
+			},
+			// http & https
+			"keep_session_type": {
+				Type:             schema.TypeString,
+				Description:      "KeepSessionType option, support insert/rewrite, default insert",
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringInSlice([]string{"insert", "rewrite"}, false),
+				DiffSuppressFunc: appBlbProtocolTCPUDPSSLSuppressFunc,
+			},
+			// http & https
+			"keep_session_duration": {
+				Type:             schema.TypeInt,
+				Description:      "KeepSession Cookie timeout time(second), support in [1, 15552000], default 3600s",
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IntBetween(1, 15552000),
+				DiffSuppressFunc: appBlbProtocolTCPUDPSSLSuppressFunc,
+			},
+			// http & https
+			"keep_session_cookie_name": {
+				Type:             schema.TypeString,
+				Description:      "CookieName which need to covered, useful when keep_session_type is rewrite",
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: appBlbProtocolTCPUDPSSLSuppressFunc,
+			},
+			// http & https
+			"x_forwarded_for": {
+				Type:             schema.TypeBool,
+				Description:      "Listener xForwardedFor, determine get client real ip or not, default false",
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: appBlbProtocolTCPUDPSSLSuppressFunc,
+			},
+			// http & https
+			"health_check_type": {
+				Type:             schema.TypeString,
+				Description:      "Health check protocol, support HTTP/TCP, default HTTP",
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringInSlice([]string{HTTP, TCP}, false),
+				DiffSuppressFunc: appBlbProtocolTCPUDPSSLSuppressFunc,
+			},
+			// http & https
+			"health_check_port": {
+				Type:             schema.TypeInt,
+				Description:      "Health check port, default same as backend_port",
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validatePort(),
+				DiffSuppressFunc: appBlbProtocolTCPUDPSSLSuppressFunc,
+			},
+			// http & https
+			"health_check_uri": {
+				Type:             schema.TypeString,
+				Description:      "Health check uri, default /",
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: appBlbProtocolTCPUDPSSLSuppressFunc,
+			},
+			// http & https
+			"health_check_normal_status": {
+				Type:             schema.TypeString,
+				Description:      "Health check normal http status code, such as http_2xx|http_3xx",
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: appBlbProtocolTCPUDPSSLSuppressFunc,
+			},
+			// http & https
+			"server_timeout": {
+				Type:             schema.TypeInt,
+				Description:      "Backend server maximum timeout time, only support in [1, 3600] second, default 30s",
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IntBetween(1, 3600),
+				DiffSuppressFunc: appBlbProtocolTCPUDPSSLSuppressFunc,
+			},
+			// http
+			"redirect_port": {
+				Type:        schema.TypeInt,
+				Description: "Redirect HTTP request to HTTPS Listener, HTTPS Listener port set by this parameter",
+				Optional:    true,
+				Computed:    true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Get("protocol").(string) != HTTP
+				},
+			},
+			// https && ssl
+			"cert_ids": {
+				Type:        schema.TypeSet,
+				Description: "Listener bind certifications",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				DiffSuppressFunc: appBlbProtocolTCPUDPHTTPSuppressFunc,
+			},
+			// https && ssl
+			"ie6_compatible": {
+				Type:             schema.TypeBool,
+				Description:      "Listener support ie6 option, default true",
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: appBlbProtocolTCPUDPHTTPSuppressFunc,
+			},
+			// https && ssl
+			"encryption_type": {
+				Type:             schema.TypeString,
+				Description:      "Listener encryption option, support [compatibleIE, incompatibleIE, userDefind], can't be updated when protocol is HTTPS",
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringInSlice([]string{"compatibleIE", "incompatibleIE", "userDefind"}, false),
+				DiffSuppressFunc: appBlbProtocolTCPUDPHTTPSuppressFunc,
+			},
+			// https && ssl
+			"encryption_protocols": {
+				Type:        schema.TypeSet,
+				Description: "Listener encryption protocol, only useful when encryption_type is userDefind, support [sslv3, tlsv10, tlsv11, tlsv12], can't be updated when protocol is HTTPS",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"sslv3", "tlsv10", "tlsv11", "tlsv12"}, false),
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if v, ok := d.GetOk("encryption_type"); ok {
+						return v.(string) != "userDefind"
+					}
+
+					return true
+				},
+			},
+			// https && ssl
+			"dual_auth": {
+				Type:             schema.TypeBool,
+				Description:      "Listener open dual authorization or not, default false, can't be updated when protocol is HTTPS",
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: appBlbProtocolTCPUDPHTTPSuppressFunc,
+			},
+			// https && ssl
+			"client_cert_ids": {
+				Type:        schema.TypeSet,
+				Description: "Listener import cert list, only useful when dual_auth is true, can't be updated when protocol is HTTPS",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				DiffSuppressFunc: appBlbProtocolTCPUDPHTTPSuppressFunc,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudBlbListenerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	blbId := d.Get("blb_id").(string)
+	protocol := d.Get("protocol").(string)
+	listenerPort := d.Get("listener_port").(int)
+	action := fmt.Sprintf("Create BLB %s Listener [%s:%d]", blbId, protocol, listenerPort)
+
+	args, err := buildBaiduCloudCreateBlbListenerArgs(d)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err := client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+			switch protocol {
+			case TCP:
+				return nil, client.CreateTCPListener(blbId, args.(*blb.CreateTCPListenerArgs))
+			case UDP:
+				return nil, client.CreateUDPListener(blbId, args.(*blb.CreateUDPListenerArgs))
+			case HTTP:
+				return nil, client.CreateHTTPListener(blbId, args.(*blb.CreateHTTPListenerArgs))
+			case HTTPS:
+				return nil, client.CreateHTTPSListener(blbId, args.(*blb.CreateHTTPSListenerArgs))
+			case SSL:
+				return nil, client.CreateSSLListener(blbId, args.(*blb.CreateSSLListenerArgs))
+			default:
+				// never run here
+				return nil, fmt.Errorf("unsupport protocol")
+			}
+		})
+		addDebug(action, args)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_blb_listener", action, BCESDKGoERROR)
+	}
+
+	d.SetId(strings.Join([]string{blbId, protocol, strconv.Itoa(listenerPort)}, COLON_SEPARATED))
+
+	return resourceBaiduCloudBlbListenerRead(d, meta)
+}
+
+func resourceBaiduCloudBlbListenerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	blbService := BLBService{client}
+
+	items := strings.Split(d.Id(), COLON_SEPARATED)
+	if len(items) != 3 {
+		return WrapError(fmt.Errorf("invalid BLB Listener id %s, should be blb_id,protocol,listener_port", d.Id()))
+	}
+	blbId, protocol := items[0], items[1]
+	listenerPort, err := strconv.Atoi(items[2])
+	if err != nil {
+		return WrapError(fmt.Errorf("invalid listener_port in BLB Listener id %s", d.Id()))
+	}
+	action := fmt.Sprintf("Query BLB %s Listener [%s:%d]", blbId, protocol, listenerPort)
+
+	raw, err := blbService.DescribeListener(blbId, protocol, listenerPort)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_blb_listener", action, BCESDKGoERROR)
+	}
+	addDebug(action, raw)
+
+	d.Set("blb_id", blbId)
+	d.Set("protocol", protocol)
+	d.Set("listener_port", listenerPort)
+
+	switch protocol {
+	case TCP:
+		listener := raw.(*blb.TCPListenerModel)
+		d.Set("backend_port", listener.BackendPort)
+		d.Set("scheduler", listener.Scheduler)
+		d.Set("tcp_session_timeout", listener.TcpSessionTimeout)
+		d.Set("health_check_timeout_in_second", listener.HealthCheckTimeoutInSecond)
+		d.Set("health_check_interval", listener.HealthCheckInterval)
+		d.Set("unhealthy_threshold", listener.UnhealthyThreshold)
+		d.Set("healthy_threshold", listener.HealthyThreshold)
+	case UDP:
+		listener := raw.(*blb.UDPListenerModel)
+		d.Set("backend_port", listener.BackendPort)
+		d.Set("scheduler", listener.Scheduler)
+		d.Set("health_check_string", listener.HealthCheckString)
+		d.Set("health_check_timeout_in_second", listener.HealthCheckTimeoutInSecond)
+		d.Set("health_check_interval", listener.HealthCheckInterval)
+		d.Set("unhealthy_threshold", listener.UnhealthyThreshold)
+		d.Set("healthy_threshold", listener.HealthyThreshold)
+	case HTTP:
+		listener := raw.(*blb.HTTPListenerModel)
+		d.Set("backend_port", listener.BackendPort)
+		d.Set("scheduler", listener.Scheduler)
+		d.Set("keep_session", listener.KeepSession)
+		d.Set("keep_session_type", listener.KeepSessionType)
+		d.Set("keep_session_duration", listener.KeepSessionDuration)
+		d.Set("keep_session_cookie_name", listener.KeepSessionCookieName)
+		d.Set("x_forwarded_for", listener.XForwardedFor)
+		d.Set("health_check_type", listener.HealthCheckType)
+		d.Set("health_check_port", listener.HealthCheckPort)
+		d.Set("health_check_uri", listener.HealthCheckURI)
+		d.Set("health_check_normal_status", listener.HealthCheckNormalStatus)
+		d.Set("health_check_timeout_in_second", listener.HealthCheckTimeoutInSecond)
+		d.Set("health_check_interval", listener.HealthCheckInterval)
+		d.Set("unhealthy_threshold", listener.UnhealthyThreshold)
+		d.Set("healthy_threshold", listener.HealthyThreshold)
+		d.Set("server_timeout", listener.ServerTimeout)
+		d.Set("redirect_port", listener.RedirectPort)
+	case HTTPS:
+		listener := raw.(*blb.HTTPSListenerModel)
+		d.Set("backend_port", listener.BackendPort)
+		d.Set("scheduler", listener.Scheduler)
+		d.Set("keep_session", listener.KeepSession)
+		d.Set("keep_session_type", listener.KeepSessionType)
+		d.Set("keep_session_duration", listener.KeepSessionDuration)
+		d.Set("keep_session_cookie_name", listener.KeepSessionCookieName)
+		d.Set("x_forwarded_for", listener.XForwardedFor)
+		d.Set("health_check_type", listener.HealthCheckType)
+		d.Set("health_check_port", listener.HealthCheckPort)
+		d.Set("health_check_uri", listener.HealthCheckURI)
+		d.Set("health_check_normal_status", listener.HealthCheckNormalStatus)
+		d.Set("health_check_timeout_in_second", listener.HealthCheckTimeoutInSecond)
+		d.Set("health_check_interval", listener.HealthCheckInterval)
+		d.Set("unhealthy_threshold", listener.UnhealthyThreshold)
+		d.Set("healthy_threshold", listener.HealthyThreshold)
+		d.Set("server_timeout", listener.ServerTimeout)
+		d.Set("cert_ids", listener.CertIds)
+		d.Set("ie6_compatible", listener.Ie6Compatible)
+		d.Set("dual_auth", listener.DualAuth)
+		d.Set("client_cert_ids", listener.ClientCertIds)
+	case SSL:
+		listener := raw.(*blb.SSLListenerModel)
+		d.Set("backend_port", listener.BackendPort)
+		d.Set("scheduler", listener.Scheduler)
+		d.Set("health_check_timeout_in_second", listener.HealthCheckTimeoutInSecond)
+		d.Set("health_check_interval", listener.HealthCheckInterval)
+		d.Set("unhealthy_threshold", listener.UnhealthyThreshold)
+		d.Set("healthy_threshold", listener.HealthyThreshold)
+		d.Set("cert_ids", listener.CertIds)
+		d.Set("ie6_compatible", listener.Ie6Compatible)
+		d.Set("encryption_type", listener.EncryptionType)
+		d.Set("encryption_protocols", listener.EncryptionProtocols)
+		d.Set("dual_auth", listener.DualAuth)
+		d.Set("client_cert_ids", listener.ClientCertIds)
+	default:
+		return WrapError(fmt.Errorf("unsupport listener type"))
+	}
+
+	return nil
+}
+
+func resourceBaiduCloudBlbListenerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	blbId := d.Get("blb_id").(string)
+	protocol := d.Get("protocol").(string)
+	listenerPort := d.Get("listener_port").(int)
+	action := fmt.Sprintf("Update BLB %s Listener [%s:%d]", blbId, protocol, listenerPort)
+
+	// update api of HTTPS Listener does not support encryption and dual auth options
+	if protocol == HTTPS && (d.HasChange("encryption_type") || d.HasChange("encryption_protocols") ||
+		d.HasChange("dual_auth") || d.HasChange("client_cert_ids")) {
+		return WrapError(fmt.Errorf("encryption_type, encryption_protocols, dual_auth and client_cert_ids of HTTPS Listener can't be updated"))
+	}
+
+	args, err := buildBaiduCloudUpdateBlbListenerArgs(d)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	_, err = client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+		switch protocol {
+		case TCP:
+			return nil, client.UpdateTCPListener(blbId, args.(*blb.UpdateTCPListenerArgs))
+		case UDP:
+			return nil, client.UpdateUDPListener(blbId, args.(*blb.UpdateUDPListenerArgs))
+		case HTTP:
+			return nil, client.UpdateHTTPListener(blbId, args.(*blb.UpdateHTTPListenerArgs))
+		case HTTPS:
+			return nil, client.UpdateHTTPSListener(blbId, args.(*blb.UpdateHTTPSListenerArgs))
+		case SSL:
+			return nil, client.UpdateSSLListener(blbId, args.(*blb.UpdateSSLListenerArgs))
+		default:
+			return nil, fmt.Errorf("unsupport listener type")
+		}
+	})
+	addDebug(action, args)
+
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_blb_listener", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudBlbListenerRead(d, meta)
+}
+
+func resourceBaiduCloudBlbListenerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	blbId := d.Get("blb_id").(string)
+	protocol := d.Get("protocol").(string)
+	listenerPort := d.Get("listener_port").(int)
+	action := fmt.Sprintf("Delete BLB %s Listener [%s:%d]", blbId, protocol, listenerPort)
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+			return blbId, client.DeleteListeners(blbId, &blb.DeleteListenersArgs{
+				PortList:    []uint16{uint16(listenerPort)},
+				ClientToken: buildClientToken(),
+			})
+		})
+		addDebug(action, blbId)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		if IsExceptedErrors(err, ObjectNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_blb_listener", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func buildBaiduCloudCreateBlbListenerArgs(d *schema.ResourceData) (interface{}, error) {
+	protocol := d.Get("protocol").(string)
+	listenerPort := uint16(d.Get("listener_port").(int))
+	backendPort := uint16(d.Get("backend_port").(int))
+	scheduler := d.Get("scheduler").(string)
+
+	if (protocol == HTTP || protocol == HTTPS) && scheduler == "Hash" {
+		return nil, fmt.Errorf("%s Listener scheduler only support [RoundRobin, LeastConnection], but you set: %s", protocol, scheduler)
+	}
+
+	switch protocol {
+	case TCP:
+		return &blb.CreateTCPListenerArgs{
+			ClientToken:                buildClientToken(),
+			ListenerPort:               listenerPort,
+			BackendPort:                backendPort,
+			Scheduler:                  scheduler,
+			TcpSessionTimeout:          d.Get("tcp_session_timeout").(int),
+			HealthCheckTimeoutInSecond: d.Get("health_check_timeout_in_second").(int),
+			HealthCheckInterval:        d.Get("health_check_interval").(int),
+			UnhealthyThreshold:         d.Get("unhealthy_threshold").(int),
+			HealthyThreshold:           d.Get("healthy_threshold").(int),
+		}, nil
+	case UDP:
+		healthCheckString := d.Get("health_check_string").(string)
+		if healthCheckString == "" {
+			return nil, fmt.Errorf("health_check_string is required when protocol is UDP")
+		}
+
+		return &blb.CreateUDPListenerArgs{
+			ClientToken:                buildClientToken(),
+			ListenerPort:               listenerPort,
+			BackendPort:                backendPort,
+			Scheduler:                  scheduler,
+			HealthCheckString:          healthCheckString,
+			HealthCheckTimeoutInSecond: d.Get("health_check_timeout_in_second").(int),
+			HealthCheckInterval:        d.Get("health_check_interval").(int),
+			UnhealthyThreshold:         d.Get("unhealthy_threshold").(int),
+			HealthyThreshold:           d.Get("healthy_threshold").(int),
+		}, nil
+	case HTTP:
+		return &blb.CreateHTTPListenerArgs{
+			ClientToken:                buildClientToken(),
+			ListenerPort:               listenerPort,
+			BackendPort:                backendPort,
+			Scheduler:                  scheduler,
+			KeepSession:                d.Get("keep_session").(bool),
+			KeepSessionType:            d.Get("keep_session_type").(string),
+			KeepSessionDuration:        d.Get("keep_session_duration").(int),
+			KeepSessionCookieName:      d.Get("keep_session_cookie_name").(string),
+			XForwardedFor:              d.Get("x_forwarded_for").(bool),
+			HealthCheckType:            d.Get("health_check_type").(string),
+			HealthCheckPort:            uint16(d.Get("health_check_port").(int)),
+			HealthCheckURI:             d.Get("health_check_uri").(string),
+			HealthCheckTimeoutInSecond: d.Get("health_check_timeout_in_second").(int),
+			HealthCheckInterval:        d.Get("health_check_interval").(int),
+			UnhealthyThreshold:         d.Get("unhealthy_threshold").(int),
+			HealthyThreshold:           d.Get("healthy_threshold").(int),
+			HealthCheckNormalStatus:    d.Get("health_check_normal_status").(string),
+			ServerTimeout:              d.Get("server_timeout").(int),
+			RedirectPort:               uint16(d.Get("redirect_port").(int)),
+		}, nil
+	case HTTPS:
+		certIds := expandStringSet(d.Get("cert_ids").(*schema.Set))
+		if len(certIds) == 0 {
+			return nil, fmt.Errorf("cert_ids is required when protocol is HTTPS")
+		}
+
+		return &blb.CreateHTTPSListenerArgs{
+			ClientToken:                buildClientToken(),
+			ListenerPort:               listenerPort,
+			BackendPort:                backendPort,
+			Scheduler:                  scheduler,
+			CertIds:                    certIds,
+			KeepSession:                d.Get("keep_session").(bool),
+			KeepSessionType:            d.Get("keep_session_type").(string),
+			KeepSessionDuration:        d.Get("keep_session_duration").(int),
+			KeepSessionCookieName:      d.Get("keep_session_cookie_name").(string),
+			XForwardedFor:              d.Get("x_forwarded_for").(bool),
+			HealthCheckType:            d.Get("health_check_type").(string),
+			HealthCheckPort:            uint16(d.Get("health_check_port").(int)),
+			HealthCheckURI:             d.Get("health_check_uri").(string),
+			HealthCheckTimeoutInSecond: d.Get("health_check_timeout_in_second").(int),
+			HealthCheckInterval:        d.Get("health_check_interval").(int),
+			UnhealthyThreshold:         d.Get("unhealthy_threshold").(int),
+			HealthyThreshold:           d.Get("healthy_threshold").(int),
+			HealthCheckNormalStatus:    d.Get("health_check_normal_status").(string),
+			ServerTimeout:              d.Get("server_timeout").(int),
+			Ie6Compatible:              d.Get("ie6_compatible").(bool),
+			EncryptionType:             d.Get("encryption_type").(string),
+			EncryptionProtocols:        expandStringSet(d.Get("encryption_protocols").(*schema.Set)),
+			DualAuth:                   d.Get("dual_auth").(bool),
+			ClientCertIds:              expandStringSet(d.Get("client_cert_ids").(*schema.Set)),
+		}, nil
+	case SSL:
+		certIds := expandStringSet(d.Get("cert_ids").(*schema.Set))
+		if len(certIds) == 0 {
+			return nil, fmt.Errorf("cert_ids is required when protocol is SSL")
+		}
+
+		return &blb.CreateSSLListenerArgs{
+			ClientToken:                buildClientToken(),
+			ListenerPort:               listenerPort,
+			BackendPort:                backendPort,
+			Scheduler:                  scheduler,
+			CertIds:                    certIds,
+			HealthCheckTimeoutInSecond: d.Get("health_check_timeout_in_second").(int),
+			HealthCheckInterval:        d.Get("health_check_interval").(int),
+			UnhealthyThreshold:         d.Get("unhealthy_threshold").(int),
+			HealthyThreshold:           d.Get("healthy_threshold").(int),
+			Ie6Compatible:              d.Get("ie6_compatible").(bool),
+			EncryptionType:             d.Get("encryption_type").(string),
+			EncryptionProtocols:        expandStringSet(d.Get("encryption_protocols").(*schema.Set)),
+			DualAuth:                   d.Get("dual_auth").(bool),
+			ClientCertIds:              expandStringSet(d.Get("client_cert_ids").(*schema.Set)),
+		}, nil
+	default:
+		// never run here
+		return nil, fmt.Errorf("listener only support protocol [TCP, UDP, HTTP, HTTPS, SSL], but now set: %s", protocol)
+	}
+}
+
+func buildBaiduCloudUpdateBlbListenerArgs(d *schema.ResourceData) (interface{}, error) {
+	protocol := d.Get("protocol").(string)
+	listenerPort := uint16(d.Get("listener_port").(int))
+	backendPort := uint16(d.Get("backend_port").(int))
+	scheduler := d.Get("scheduler").(string)
+
+	if (protocol == HTTP || protocol == HTTPS) && scheduler == "Hash" {
+		return nil, fmt.Errorf("%s Listener scheduler only support [RoundRobin, LeastConnection], but you set: %s", protocol, scheduler)
+	}
+
+	switch protocol {
+	case TCP:
+		return &blb.UpdateTCPListenerArgs{
+			ClientToken:                buildClientToken(),
+			ListenerPort:               listenerPort,
+			BackendPort:                backendPort,
+			Scheduler:                  scheduler,
+			TcpSessionTimeout:          d.Get("tcp_session_timeout").(int),
+			HealthCheckTimeoutInSecond: d.Get("health_check_timeout_in_second").(int),
+			HealthCheckInterval:        d.Get("health_check_interval").(int),
+			UnhealthyThreshold:         d.Get("unhealthy_threshold").(int),
+			HealthyThreshold:           d.Get("healthy_threshold").(int),
+		}, nil
+	case UDP:
+		return &blb.UpdateUDPListenerArgs{
+			ClientToken:                buildClientToken(),
+			ListenerPort:               listenerPort,
+			BackendPort:                backendPort,
+			Scheduler:                  scheduler,
+			HealthCheckString:          d.Get("health_check_string").(string),
+			HealthCheckTimeoutInSecond: d.Get("health_check_timeout_in_second").(int),
+			HealthCheckInterval:        d.Get("health_check_interval").(int),
+			UnhealthyThreshold:         d.Get("unhealthy_threshold").(int),
+			HealthyThreshold:           d.Get("healthy_threshold").(int),
+		}, nil
+	case HTTP:
+		return &blb.UpdateHTTPListenerArgs{
+			ClientToken:                buildClientToken(),
+			ListenerPort:               listenerPort,
+			BackendPort:                backendPort,
+			Scheduler:                  scheduler,
+			KeepSession:                d.Get("keep_session").(bool),
+			KeepSessionType:            d.Get("keep_session_type").(string),
+			KeepSessionDuration:        d.Get("keep_session_duration").(int),
+			KeepSessionCookieName:      d.Get("keep_session_cookie_name").(string),
+			XForwardedFor:              d.Get("x_forwarded_for").(bool),
+			HealthCheckType:            d.Get("health_check_type").(string),
+			HealthCheckPort:            uint16(d.Get("health_check_port").(int)),
+			HealthCheckURI:             d.Get("health_check_uri").(string),
+			HealthCheckTimeoutInSecond: d.Get("health_check_timeout_in_second").(int),
+			HealthCheckInterval:        d.Get("health_check_interval").(int),
+			UnhealthyThreshold:         d.Get("unhealthy_threshold").(int),
+			HealthyThreshold:           d.Get("healthy_threshold").(int),
+			HealthCheckNormalStatus:    d.Get("health_check_normal_status").(string),
+			ServerTimeout:              d.Get("server_timeout").(int),
+			RedirectPort:               uint16(d.Get("redirect_port").(int)),
+		}, nil
+	case HTTPS:
+		return &blb.UpdateHTTPSListenerArgs{
+			ClientToken:                buildClientToken(),
+			ListenerPort:               listenerPort,
+			BackendPort:                backendPort,
+			Scheduler:                  scheduler,
+			KeepSession:                d.Get("keep_session").(bool),
+			KeepSessionType:            d.Get("keep_session_type").(string),
+			KeepSessionDuration:        d.Get("keep_session_duration").(int),
+			KeepSessionCookieName:      d.Get("keep_session_cookie_name").(string),
+			XForwardedFor:              d.Get("x_forwarded_for").(bool),
+			HealthCheckType:            d.Get("health_check_type").(string),
+			HealthCheckPort:            uint16(d.Get("health_check_port").(int)),
+			HealthCheckURI:             d.Get("health_check_uri").(string),
+			HealthCheckTimeoutInSecond: d.Get("health_check_timeout_in_second").(int),
+			HealthCheckInterval:        d.Get("health_check_interval").(int),
+			UnhealthyThreshold:         d.Get("unhealthy_threshold").(int),
+			HealthyThreshold:           d.Get("healthy_threshold").(int),
+			HealthCheckNormalStatus:    d.Get("health_check_normal_status").(string),
+			ServerTimeout:              d.Get("server_timeout").(int),
+			CertIds:                    expandStringSet(d.Get("cert_ids").(*schema.Set)),
+			Ie6Compatible:              d.Get("ie6_compatible").(bool),
+		}, nil
+	case SSL:
+		return &blb.UpdateSSLListenerArgs{
+			ClientToken:                buildClientToken(),
+			ListenerPort:               listenerPort,
+			BackendPort:                backendPort,
+			Scheduler:                  scheduler,
+			HealthCheckTimeoutInSecond: d.Get("health_check_timeout_in_second").(int),
+			HealthCheckInterval:        d.Get("health_check_interval").(int),
+			UnhealthyThreshold:         d.Get("unhealthy_threshold").(int),
+			HealthyThreshold:           d.Get("healthy_threshold").(int),
+			CertIds:                    expandStringSet(d.Get("cert_ids").(*schema.Set)),
+			Ie6Compatible:              d.Get("ie6_compatible").(bool),
+			EncryptionType:             d.Get("encryption_type").(string),
+			EncryptionProtocols:        expandStringSet(d.Get("encryption_protocols").(*schema.Set)),
+			DualAuth:                   d.Get("dual_auth").(bool),
+			ClientCertIds:              expandStringSet(d.Get("client_cert_ids").(*schema.Set)),
+		}, nil
+	default:
+		// never run here
+		return nil, fmt.Errorf("listener only support protocol [TCP, UDP, HTTP, HTTPS, SSL], but now set: %s", protocol)
+	}
+}
@@ -0,0 +1,137 @@
+package baiducloud
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	testAccBLBListenerResourceType = "baiducloud_blb_listener"
+	testAccBLBListenerResourceName = testAccBLBListenerResourceType + "." + BaiduCloudTestResourceName
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBLBListener_TCPListener(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBLBListenerDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBLBListenerTCPConfig("LeastConnection"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBLBListenerResourceName),
+					resource.TestCheckResourceAttr(testAccBLBListenerResourceName, "listener_port", "124"),
+					resource.TestCheckResourceAttr(testAccBLBListenerResourceName, "backend_port", "8080"),
+					resource.TestCheckResourceAttr(testAccBLBListenerResourceName, "protocol", "TCP"),
+					resource.TestCheckResourceAttr(testAccBLBListenerResourceName, "scheduler", "LeastConnection"),
+					resource.TestCheckResourceAttrSet(testAccBLBListenerResourceName, "health_check_interval"),
+				),
+			},
+			{
+				ResourceName:      testAccBLBListenerResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBLBListenerTCPConfig("RoundRobin"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBLBListenerResourceName),
+					resource.TestCheckResourceAttr(testAccBLBListenerResourceName, "scheduler", "RoundRobin"),
+				),
+			},
+		},
+	})
+}
+
+//lintignore:AT003
+func TestAccBaiduCloudBLBListener_HTTPListener(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBLBListenerDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBLBListenerHTTPConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBLBListenerResourceName),
+					resource.TestCheckResourceAttr(testAccBLBListenerResourceName, "listener_port", "129"),
+					resource.TestCheckResourceAttr(testAccBLBListenerResourceName, "protocol", "HTTP"),
+					resource.TestCheckResourceAttr(testAccBLBListenerResourceName, "keep_session", "false"),
+					resource.TestCheckResourceAttr(testAccBLBListenerResourceName, "health_check_uri", "/health"),
+				),
+			},
+			{
+				Config: testAccBLBListenerHTTPConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBLBListenerResourceName),
+					resource.TestCheckResourceAttr(testAccBLBListenerResourceName, "keep_session", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBLBListenerDestory(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.BaiduClient)
+	blbService := BLBService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccBLBListenerResourceType {
+			continue
+		}
+
+		port, _ := strconv.Atoi(rs.Primary.Attributes["listener_port"])
+		_, err := blbService.DescribeListener(rs.Primary.Attributes["blb_id"], rs.Primary.Attributes["protocol"], port)
+		if err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return WrapError(err)
+		}
+
+		return WrapError(Error("BLB Listener still exist"))
+	}
+
+	return nil
+}
+
+func testAccBLBListenerTCPConfig(scheduler string) string {
+	return testAccBLBConfig(testAccBLBResourceAttrName, "") + fmt.Sprintf(`
+resource "%s" "%s" {
+  blb_id        = %s.%s.id
+  listener_port = 124
+  backend_port  = 8080
+  protocol      = "TCP"
+  scheduler     = "%s"
+}
+`, testAccBLBListenerResourceType, BaiduCloudTestResourceName,
+		testAccBLBResourceType, BaiduCloudTestResourceName, scheduler)
+}
+
+func testAccBLBListenerHTTPConfig(keepSession bool) string {
+	return testAccBLBConfig(testAccBLBResourceAttrName, "") + fmt.Sprintf(`
+resource "%s" "%s" {
+  blb_id           = %s.%s.id
+  listener_port    = 129
+  backend_port     = 8080
+  protocol         = "HTTP"
+  scheduler        = "RoundRobin"
+  keep_session     = %t
+  health_check_uri = "/health"
+}
+`, testAccBLBListenerResourceType, BaiduCloudTestResourceName,
+		testAccBLBResourceType, BaiduCloudTestResourceName, keepSession)
+}
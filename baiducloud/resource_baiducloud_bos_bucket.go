@@ -110,6 +110,27 @@ resource "baiducloud_bos_bucket" "default" {
 }
 ```
 
+Using trash
+```hcl
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "${var.bucket}"
+
+  trash {
+    trash_dir = ".trash"
+  }
+}
+```
+
+Using KMS server side encryption
+```hcl
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "${var.bucket}"
+
+  server_side_encryption_rule = "KMS"
+  kms_key_id                  = "${var.kms_key_id}"
+}
+```
+
 Import
 
 BOS bucket can be imported, e.g.
@@ -122,6 +143,7 @@ package baiducloud
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/baidubce/bce-sdk-go/services/bos"
@@ -141,6 +163,8 @@ func resourceBaiduCloudBosBucket() *schema.Resource {
 		Update: resourceBaiduCloudBosBucketUpdate,
 		Delete: resourceBaiduCloudBosBucketDelete,
 
+		CustomizeDiff: resourceBaiduCloudBosBucketCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -160,15 +184,16 @@ func resourceBaiduCloudBosBucket() *schema.Resource {
 			},
 			"acl": {
 				Type:         schema.TypeString,
-				Description:  "Canned ACL to apply, available values are private, public-read and public-read-write. Default to private.",
+				Description:  "Canned ACL to apply, available values are private, public-read and public-read-write. Default to private. The drift of it is not detected when the bucket acl is a policy managed by baiducloud_bos_bucket_policy.",
 				Optional:     true,
 				Default:      api.CANNED_ACL_PRIVATE,
 				ValidateFunc: validateBOSBucketACL(),
 			},
 			"replication_configuration": {
 				Type:        schema.TypeList,
-				Description: "Replication configuration of the BOS bucket.",
+				Description: "Replication configuration of the BOS bucket. If not set, the replication configuration of the bucket is left as it is, so that it can be managed by baiducloud_bos_bucket_replication instead, and removing it from the configuration does not delete the replication. Do not use it together with baiducloud_bos_bucket_replication resources of the same bucket.",
 				Optional:    true,
+				Computed:    true,
 				MaxItems:    1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -363,11 +388,19 @@ func resourceBaiduCloudBosBucket() *schema.Resource {
 			},
 
 			"server_side_encryption_rule": {
-				Type:         schema.TypeString,
-				Description:  "Encryption rule for the server side, which can only be AES256 currently.",
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validation.StringInSlice([]string{"AES256"}, false),
+				Type:        schema.TypeString,
+				Description: "Encryption rule for the server side, which can be AES256 or KMS.",
+				Optional:    true,
+				Computed:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					BOS_SERVER_SIDE_ENCRYPTION_AES256,
+					BOS_SERVER_SIDE_ENCRYPTION_KMS,
+				}, false),
+			},
+			"kms_key_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the KMS key used to encrypt the objects by default, only valid when server_side_encryption_rule is KMS. The key chosen by the service is not read when it is not set.",
+				Optional:    true,
 			},
 
 			"website": {
@@ -453,9 +486,26 @@ func resourceBaiduCloudBosBucket() *schema.Resource {
 				},
 			},
 
+			"trash": {
+				Type:        schema.TypeList,
+				Description: "Trash of the BOS bucket, the deleted objects are moved to the trash directory and can be recovered.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"trash_dir": {
+							Type:        schema.TypeString,
+							Description: "Name of the trash directory. Default to .trash.",
+							Optional:    true,
+							Default:     BOS_BUCKET_DEFAULT_TRASH_DIR,
+						},
+					},
+				},
+			},
+
 			"force_destroy": {
 				Type:        schema.TypeBool,
-				Description: "Whether to force delete the bucket and related objects when the bucket is not empty. Default to false.",
+				Description: "Whether to force delete the bucket and related objects when the bucket is not empty. Default to false. The trash is disabled before deleting the objects, so the objects in the trash directory are deleted permanently as well. If the bucket fails to be deleted, the trash is enabled again, while the objects already deleted are not recovered.",
 				Optional:    true,
 				Default:     false,
 			},
@@ -534,11 +584,15 @@ func resourceBaiduCloudBosBucketRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	// read bucket acl
-	acl, err := bosService.resourceBaiduCloudBosBucketReadAcl(bucket)
+	acl, canned, err := bosService.resourceBaiduCloudBosBucketReadAcl(bucket)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket", action, BCESDKGoERROR)
 	}
-	d.Set("acl", acl)
+	// the acl put by baiducloud_bos_bucket_policy is not a canned one, leave acl as it is so that
+	// the two resources do not overwrite each other
+	if canned {
+		d.Set("acl", acl)
+	}
 
 	// read replication configuration
 	rc, err := bosService.resourceBaiduCloudBosBucketReadReplicationConfigure(bucket)
@@ -571,13 +625,14 @@ func resourceBaiduCloudBosBucketRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("storage_class", raw.(string))
 
 	// read server_side_encryption_rule
-	raw, err = client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
-		return bosClient.GetBucketEncryption(bucket)
-	})
+	encryption, err := bosService.GetBucketEncryption(bucket)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket", action, BCESDKGoERROR)
 	}
-	d.Set("server_side_encryption_rule", raw.(string))
+	d.Set("server_side_encryption_rule", encryption.EncryptionAlgorithm)
+	if d.Get("kms_key_id").(string) != "" {
+		d.Set("kms_key_id", encryption.KmsKeyId)
+	}
 
 	// read website
 	website, err := bosService.resourceBaiduCloudBosBucketReadWebsite(bucket)
@@ -600,6 +655,13 @@ func resourceBaiduCloudBosBucketRead(d *schema.ResourceData, meta interface{}) e
 	}
 	d.Set("copyright_protection", copyright)
 
+	// read trash
+	trash, err := bosService.resourceBaiduCloudBosBucketReadTrash(bucket)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket", action, BCESDKGoERROR)
+	}
+	d.Set("trash", trash)
+
 	return nil
 }
 
@@ -661,11 +723,12 @@ func resourceBaiduCloudBosBucketUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	// update server_side_encryption_rule
-	if d.HasChange("server_side_encryption_rule") {
+	if d.HasChange("server_side_encryption_rule") || d.HasChange("kms_key_id") {
 		if err := resourceBaiduCloudBosBucketEncryptionUpdate(d, client); err != nil {
 			return err
 		}
 		d.SetPartial("server_side_encryption_rule")
+		d.SetPartial("kms_key_id")
 	}
 
 	// update website
@@ -692,11 +755,23 @@ func resourceBaiduCloudBosBucketUpdate(d *schema.ResourceData, meta interface{})
 		d.SetPartial("copyright_protection")
 	}
 
+	// update trash
+	if d.HasChange("trash") {
+		if err := resourceBaiduCloudBosBucketTrashUpdate(d, client); err != nil {
+			return err
+		}
+		d.SetPartial("trash")
+	}
+
 	d.Partial(false)
 
 	return resourceBaiduCloudBosBucketRead(d, meta)
 }
 
+func resourceBaiduCloudBosBucketCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	return customizeBosKmsKeyIdDiff(d, "server_side_encryption_rule")
+}
+
 func resourceBaiduCloudBosBucketDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
 	bosService := BosService{client}
@@ -704,6 +779,8 @@ func resourceBaiduCloudBosBucketDelete(d *schema.ResourceData, meta interface{})
 	bucket := d.Id()
 	action := "Delete Bucket " + bucket
 
+	// trash directory disabled to empty the bucket, it is enabled again if the bucket fails to be deleted
+	disabledTrashDir := ""
 	errRetry := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, errDelete := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
 			return nil, bosClient.DeleteBucket(bucket)
@@ -717,6 +794,22 @@ func resourceBaiduCloudBosBucketDelete(d *schema.ResourceData, meta interface{})
 			if IsExceptedErrors(errDelete, []string{"BucketNotEmpty"}) {
 				action += " objects"
 
+				// the deleted objects are moved to the trash directory if the trash is enabled,
+				// disable it so that the objects are deleted permanently and the bucket can be emptied
+				trashDir, err := bosService.GetBucketTrashDir(bucket)
+				if err != nil {
+					return resource.NonRetryableError(err)
+				}
+				if trashDir != "" {
+					_, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+						return nil, bosClient.DeleteBucketTrash(bucket)
+					})
+					if err != nil {
+						return resource.NonRetryableError(err)
+					}
+					disabledTrashDir = trashDir
+				}
+
 				objects, err := bosService.ListAllObjects(bucket, "")
 				if err != nil {
 					return resource.NonRetryableError(err)
@@ -751,6 +844,15 @@ func resourceBaiduCloudBosBucketDelete(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if errRetry != nil {
+		if disabledTrashDir != "" {
+			_, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+				return nil, bosClient.PutBucketTrash(bucket, api.PutBucketTrashReq{TrashDir: disabledTrashDir})
+			})
+			if err != nil {
+				errRetry = fmt.Errorf("%s, and the trash %s disabled to empty the bucket failed to be enabled again: %s",
+					errRetry, disabledTrashDir, err)
+			}
+		}
 		return WrapErrorf(errRetry, DefaultErrorMsg, "baiducloud_bos_bucket", action, BCESDKGoERROR)
 	}
 
@@ -1004,11 +1106,9 @@ func resourceBaiduCloudBosBucketEncryptionUpdate(d *schema.ResourceData, client
 	var err error
 	rule, ok := d.GetOk("server_side_encryption_rule")
 	if ok {
-		// add rule
-		_, err = client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
-			return nil, bosClient.PutBucketEncryption(bucket, rule.(string))
-		})
-
+		// add rule, kms_key_id set along with other rules is rejected by CustomizeDiff
+		bosService := &BosService{client}
+		err = bosService.PutBucketEncryption(bucket, rule.(string), d.Get("kms_key_id").(string))
 	} else {
 		// delete rule
 		_, err = client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
@@ -1144,3 +1244,35 @@ func resourceBaiduCloudBosBucketCopyrightProtectionUpdate(d *schema.ResourceData
 
 	return nil
 }
+
+func resourceBaiduCloudBosBucketTrashUpdate(d *schema.ResourceData, client *connectivity.BaiduClient) error {
+	bucket := d.Get("bucket").(string)
+	action := "Update BOS Bucket trash"
+
+	var err error
+	trash, ok := d.GetOk("trash")
+	if ok && len(trash.([]interface{})) > 0 {
+		// put directly
+		trashDir := BOS_BUCKET_DEFAULT_TRASH_DIR
+		if t, ok := trash.([]interface{})[0].(map[string]interface{}); ok && t["trash_dir"].(string) != "" {
+			trashDir = t["trash_dir"].(string)
+		}
+
+		args := api.PutBucketTrashReq{
+			TrashDir: trashDir,
+		}
+		_, err = client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+			return nil, bosClient.PutBucketTrash(bucket, args)
+		})
+	} else {
+		// delete trash
+		_, err = client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+			return nil, bosClient.DeleteBucketTrash(bucket)
+		})
+	}
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
@@ -0,0 +1,377 @@
+/*
+Provide a resource to manage the event notifications of a BOS Bucket. The notifications are sent to webhooks or CFC
+functions, and the resource manages the whole notification configuration of the bucket.
+
+Example Usage
+
+```hcl
+resource "baiducloud_bos_bucket_notification" "default" {
+  bucket = "my-bucket"
+
+  notification {
+    id     = "image-uploaded"
+    name   = "image-uploaded"
+    app_id = "image-processor"
+    events = ["PutObject", "PostObject", "CompleteMultipartUpload"]
+
+    filter {
+      prefix = "images/"
+      suffix = ".jpg"
+    }
+
+    app {
+      id        = "webhook"
+      event_url = "https://example.com/bos/events"
+    }
+  }
+
+  notification {
+    id     = "object-deleted"
+    status = "disabled"
+    events = ["DeleteObject"]
+
+    app {
+      id        = "cfc"
+      event_url = "brn:bce:cfc:bj:8f6fc5d4a4f7a8e3a0d1a2b3c4d5e6f7:function:on-delete:$LATEST"
+    }
+  }
+}
+```
+
+Import
+
+BOS Bucket Notification can be imported by bucket name, e.g.
+
+```hcl
+$ terraform import baiducloud_bos_bucket_notification.default my-bucket
+```
+*/
+package baiducloud
+
+import (
+	"strings"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/services/bos"
+	"github.com/baidubce/bce-sdk-go/services/bos/api"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudBosBucketNotification() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudBosBucketNotificationCreate,
+		Read:   resourceBaiduCloudBosBucketNotificationRead,
+		Update: resourceBaiduCloudBosBucketNotificationUpdate,
+		Delete: resourceBaiduCloudBosBucketNotificationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Description: "Name of the bucket.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"notification": {
+				Type:        schema.TypeList,
+				Description: "Notification rules of the bucket.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the notification rule, which must be unique in the bucket.",
+							Required:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the notification rule.",
+							Optional:    true,
+							Computed:    true,
+						},
+						"app_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the application receiving the notifications.",
+							Optional:    true,
+							Computed:    true,
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Description:  "Status of the notification rule, valid values are enabled and disabled. Default to enabled.",
+							Optional:     true,
+							Default:      BOS_NOTIFICATION_STATUS_ENABLED,
+							ValidateFunc: validation.StringInSlice([]string{BOS_NOTIFICATION_STATUS_ENABLED, BOS_NOTIFICATION_STATUS_DISABLED}, false),
+						},
+						"events": {
+							Type:        schema.TypeSet,
+							Description: "Events triggering the notification, such as PutObject, PostObject, AppendObject, CopyObject, CompleteMultipartUpload and DeleteObject.",
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"filter": {
+							Type:        schema.TypeList,
+							Description: "Key filters of the objects triggering the notification, the notification is triggered by all the objects of the bucket if not set.",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix": {
+										Type:        schema.TypeString,
+										Description: "Prefix of the object keys.",
+										Optional:    true,
+									},
+									"suffix": {
+										Type:        schema.TypeString,
+										Description: "Suffix of the object keys.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"app": {
+							Type:        schema.TypeList,
+							Description: "Targets the notifications are sent to.",
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Description: "ID of the target.",
+										Required:    true,
+									},
+									"event_url": {
+										Type:         schema.TypeString,
+										Description:  "Address of the target, which is a http(s) webhook url or the brn of a CFC function.",
+										Required:     true,
+										ValidateFunc: validateBOSNotificationEventUrl,
+									},
+									"x_vars": {
+										Type:        schema.TypeString,
+										Description: "Custom variables in json passed to the target.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudBosBucketNotificationCreate(d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+	action := "Create Bucket " + bucket + " Notification"
+
+	if err := putBaiduCloudBosBucketNotification(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_notification", action, BCESDKGoERROR)
+	}
+	d.SetId(bucket)
+
+	return resourceBaiduCloudBosBucketNotificationRead(d, meta)
+}
+
+func resourceBaiduCloudBosBucketNotificationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	bosService := &BosService{client}
+
+	bucket := d.Id()
+	action := "Query Bucket " + bucket + " Notification"
+
+	notifications, err := bosService.GetBucketNotification(bucket)
+	if err != nil {
+		if IsExceptedErrors(err, BucketNotificationNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_notification", action, BCESDKGoERROR)
+	}
+	if len(notifications) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket", bucket)
+	if err := d.Set("notification", flattenBaiduCloudBosBucketNotifications(notifications)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_notification", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func resourceBaiduCloudBosBucketNotificationUpdate(d *schema.ResourceData, meta interface{}) error {
+	action := "Update Bucket " + d.Id() + " Notification"
+
+	if d.HasChange("notification") {
+		if err := putBaiduCloudBosBucketNotification(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_notification", action, BCESDKGoERROR)
+		}
+	}
+
+	return resourceBaiduCloudBosBucketNotificationRead(d, meta)
+}
+
+func resourceBaiduCloudBosBucketNotificationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	bucket := d.Id()
+	action := "Delete Bucket " + bucket + " Notification"
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+			return nil, bosClient.DeleteBucketNotification(bucket)
+		})
+		addDebug(action, bucket)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if IsExceptedErrors(err, BucketNotificationNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_notification", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+// putBaiduCloudBosBucketNotification puts the whole notification configuration, which replaces the existing one
+func putBaiduCloudBosBucketNotification(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	bucket := d.Get("bucket").(string)
+	args := api.PutBucketNotificationReq{
+		Notifications: buildBaiduCloudBosBucketNotifications(d.Get("notification").([]interface{})),
+	}
+	action := "Put Bucket " + bucket + " Notification"
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		_, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+			return nil, bosClient.PutBucketNotification(bucket, args)
+		})
+		addDebug(action, args)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func buildBaiduCloudBosBucketNotifications(raw []interface{}) []api.PutBucketNotificationSt {
+	notifications := make([]api.PutBucketNotificationSt, 0, len(raw))
+
+	for _, r := range raw {
+		n := r.(map[string]interface{})
+		notification := api.PutBucketNotificationSt{
+			Id:        n["id"].(string),
+			Name:      n["name"].(string),
+			AppId:     n["app_id"].(string),
+			Status:    n["status"].(string),
+			Events:    expandStringSet(n["events"].(*schema.Set)),
+			Resources: []string{"/"},
+			Apps:      make([]api.PutBucketNotificationAppsSt, 0),
+		}
+
+		if filters := n["filter"].([]interface{}); len(filters) > 0 {
+			notification.Resources = make([]string, 0, len(filters))
+			for _, f := range filters {
+				filter, _ := f.(map[string]interface{})
+				prefix, suffix := "", ""
+				if filter != nil {
+					prefix = filter["prefix"].(string)
+					suffix = filter["suffix"].(string)
+				}
+				notification.Resources = append(notification.Resources, "/"+prefix+"*"+suffix)
+			}
+		}
+
+		for _, a := range n["app"].([]interface{}) {
+			app := a.(map[string]interface{})
+			notification.Apps = append(notification.Apps, api.PutBucketNotificationAppsSt{
+				Id:       app["id"].(string),
+				EventUrl: app["event_url"].(string),
+				XVars:    app["x_vars"].(string),
+			})
+		}
+
+		notifications = append(notifications, notification)
+	}
+
+	return notifications
+}
+
+func flattenBaiduCloudBosBucketNotifications(notifications []api.PutBucketNotificationSt) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(notifications))
+
+	for _, notification := range notifications {
+		filters := make([]map[string]interface{}, 0)
+		for _, res := range notification.Resources {
+			// "/" means the whole bucket
+			res = strings.TrimPrefix(res, "/")
+			if res == "" {
+				continue
+			}
+
+			prefix, suffix := res, ""
+			if index := strings.LastIndex(res, "*"); index >= 0 {
+				prefix, suffix = res[:index], res[index+1:]
+			}
+			filters = append(filters, map[string]interface{}{
+				"prefix": prefix,
+				"suffix": suffix,
+			})
+		}
+
+		apps := make([]map[string]interface{}, 0, len(notification.Apps))
+		for _, app := range notification.Apps {
+			apps = append(apps, map[string]interface{}{
+				"id":        app.Id,
+				"event_url": app.EventUrl,
+				"x_vars":    app.XVars,
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":     notification.Id,
+			"name":   notification.Name,
+			"app_id": notification.AppId,
+			"status": notification.Status,
+			"events": flattenStringListToInterface(notification.Events),
+			"filter": filters,
+			"app":    apps,
+		})
+	}
+
+	return result
+}
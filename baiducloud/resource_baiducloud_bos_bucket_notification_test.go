@@ -0,0 +1,138 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	testAccBosBucketNotificationResourceType = "baiducloud_bos_bucket_notification"
+	testAccBosBucketNotificationResourceName = testAccBosBucketNotificationResourceType + "." + BaiduCloudTestResourceName
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBosBucketNotification(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBosBucketNotificationDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBosBucketNotificationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosBucketNotificationResourceName),
+					resource.TestCheckResourceAttr(testAccBosBucketNotificationResourceName, "bucket", testAccBosBucketResourceAttrName),
+					resource.TestCheckResourceAttr(testAccBosBucketNotificationResourceName, "notification.#", "1"),
+					resource.TestCheckResourceAttr(testAccBosBucketNotificationResourceName, "notification.0.status", "enabled"),
+					resource.TestCheckResourceAttr(testAccBosBucketNotificationResourceName, "notification.0.events.#", "2"),
+					resource.TestCheckResourceAttr(testAccBosBucketNotificationResourceName, "notification.0.filter.0.prefix", "images/"),
+					resource.TestCheckResourceAttr(testAccBosBucketNotificationResourceName, "notification.0.filter.0.suffix", ".jpg"),
+					resource.TestCheckResourceAttr(testAccBosBucketNotificationResourceName, "notification.0.app.0.event_url", "https://www.example.com/bos/events"),
+				),
+			},
+			{
+				ResourceName:      testAccBosBucketNotificationResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBosBucketNotificationConfigUpdate(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosBucketNotificationResourceName),
+					resource.TestCheckResourceAttr(testAccBosBucketNotificationResourceName, "notification.#", "1"),
+					resource.TestCheckResourceAttr(testAccBosBucketNotificationResourceName, "notification.0.status", "disabled"),
+					resource.TestCheckResourceAttr(testAccBosBucketNotificationResourceName, "notification.0.events.#", "1"),
+					resource.TestCheckResourceAttr(testAccBosBucketNotificationResourceName, "notification.0.filter.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBosBucketNotificationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.BaiduClient)
+	bosService := &BosService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccBosBucketNotificationResourceType {
+			continue
+		}
+
+		notifications, err := bosService.GetBucketNotification(rs.Primary.ID)
+		if err != nil {
+			if IsExceptedErrors(err, BucketNotificationNotFound) {
+				continue
+			}
+			return WrapError(err)
+		}
+		if len(notifications) > 0 {
+			return WrapError(Error("BOS bucket notification still exist"))
+		}
+	}
+
+	return nil
+}
+
+func testAccBosBucketNotificationConfig() string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%s"
+}
+
+resource "baiducloud_bos_bucket_notification" "default" {
+  bucket = baiducloud_bos_bucket.default.bucket
+
+  notification {
+    id     = "%s"
+    name   = "%s"
+    app_id = "%s"
+    events = ["PutObject", "CompleteMultipartUpload"]
+
+    filter {
+      prefix = "images/"
+      suffix = ".jpg"
+    }
+
+    app {
+      id        = "webhook"
+      event_url = "https://www.example.com/bos/events"
+    }
+  }
+}
+`, testAccBosBucketResourceAttrName, BaiduCloudTestResourceAttrNamePrefix+"Notification",
+		BaiduCloudTestResourceAttrNamePrefix+"Notification", BaiduCloudTestResourceAttrNamePrefix+"App")
+}
+
+func testAccBosBucketNotificationConfigUpdate() string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%s"
+}
+
+resource "baiducloud_bos_bucket_notification" "default" {
+  bucket = baiducloud_bos_bucket.default.bucket
+
+  notification {
+    id     = "%s"
+    name   = "%s"
+    app_id = "%s"
+    status = "disabled"
+    events = ["DeleteObject"]
+
+    app {
+      id        = "webhook"
+      event_url = "https://www.example.com/bos/events"
+    }
+  }
+}
+`, testAccBosBucketResourceAttrName, BaiduCloudTestResourceAttrNamePrefix+"Notification",
+		BaiduCloudTestResourceAttrNamePrefix+"Notification", BaiduCloudTestResourceAttrNamePrefix+"App")
+}
@@ -11,11 +11,53 @@ resource "baiducloud_bos_bucket_object" "default" {
   acl = "public-read"
 }
 ```
+
+Source files not smaller than multipart_threshold_in_mb are uploaded in multiple parts in parallel, e.g.
+
+```hcl
+resource "baiducloud_bos_bucket_object" "default" {
+  bucket                    = "my-bucket"
+  key                       = "artifacts/image.tar.gz"
+  source                    = "/tmp/image.tar.gz"
+  multipart_threshold_in_mb = 64
+  multipart_part_size_in_mb = 32
+  multipart_concurrency     = 8
+}
+```
+
+Objects can be encrypted on the server side with a KMS key, and encrypted locally with the given key before uploading, e.g.
+
+```hcl
+resource "baiducloud_bos_bucket_object" "default" {
+  bucket                 = "my-bucket"
+  key                    = "secrets/app.conf"
+  source                 = "/tmp/app.conf"
+  server_side_encryption = "KMS"
+  kms_key_id             = "${var.kms_key_id}"
+
+  client_side_encryption {
+    key = "${var.client_side_key}"
+  }
+}
+```
 */
 package baiducloud
 
 import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -23,6 +65,7 @@ import (
 	"github.com/baidubce/bce-sdk-go/services/bos"
 	"github.com/baidubce/bce-sdk-go/services/bos/api"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
@@ -34,6 +77,8 @@ func resourceBaiduCloudBucketObject() *schema.Resource {
 		Update: resourceBaiduCloudBucketObjectPut,
 		Delete: resourceBaiduCloudBucketObjectDelete,
 
+		CustomizeDiff: resourceBaiduCloudBucketObjectCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -67,6 +112,27 @@ func resourceBaiduCloudBucketObject() *schema.Resource {
 				ForceNew:      true,
 				ConflictsWith: []string{"source"},
 			},
+			"multipart_threshold_in_mb": {
+				Type:         schema.TypeInt,
+				Description:  "Size threshold(MB) of the source file, the file not smaller than it will be uploaded in multiple parts in parallel, support between 1 and 5120. Default to 100.",
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, 5120),
+			},
+			"multipart_part_size_in_mb": {
+				Type:         schema.TypeInt,
+				Description:  "Part size(MB) of the multipart upload, support between 1 and 5120, it will be enlarged if the file is split into more than 10000 parts. Default to 12.",
+				Optional:     true,
+				Default:      12,
+				ValidateFunc: validation.IntBetween(1, 5120),
+			},
+			"multipart_concurrency": {
+				Type:         schema.TypeInt,
+				Description:  "Number of parts uploaded concurrently in the multipart upload, support between 1 and 100. Default to 10.",
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
 
 			"acl": {
 				Type:         schema.TypeString,
@@ -89,7 +155,7 @@ func resourceBaiduCloudBucketObject() *schema.Resource {
 			},
 			"content_md5": {
 				Type:        schema.TypeString,
-				Description: "MD5 digest of the HTTP request content defined in RFC2616 can be carried by the field to verify whether the file saved on the BOS side is consistent with the file expected by the user.",
+				Description: "MD5 digest of the HTTP request content defined in RFC2616 can be carried by the field to verify whether the file saved on the BOS side is consistent with the file expected by the user. For the object uploaded in multiple parts, it is verified locally before uploading.",
 				Optional:    true,
 				Computed:    true,
 			},
@@ -156,7 +222,7 @@ func resourceBaiduCloudBucketObject() *schema.Resource {
 
 			"content_sha256": {
 				Type:        schema.TypeString,
-				Description: "Sha256 value of the object, which is used to verify whether the file saved on the BOS side is consistent with the file expected by the user, the sha256 has higher verification accuracy, and the sha256 value of the transmitted data must match this, otherwise the object uploaded fails.",
+				Description: "Sha256 value of the object, which is used to verify whether the file saved on the BOS side is consistent with the file expected by the user, the sha256 has higher verification accuracy, and the sha256 value of the transmitted data must match this, otherwise the object uploaded fails. For the object uploaded in multiple parts, it is verified locally before uploading.",
 				Optional:    true,
 			},
 			"content_crc32": {
@@ -172,11 +238,44 @@ func resourceBaiduCloudBucketObject() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validateBOSBucketStorageClass(),
 			},
+			"server_side_encryption": {
+				Type:        schema.TypeString,
+				Description: "Server side encryption algorithm of the object, which can be AES256 or KMS. Default to the server_side_encryption_rule of the bucket.",
+				Optional:    true,
+				Computed:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					BOS_SERVER_SIDE_ENCRYPTION_AES256,
+					BOS_SERVER_SIDE_ENCRYPTION_KMS,
+				}, false),
+			},
+			"kms_key_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the KMS key used to encrypt the object, only valid when server_side_encryption is KMS. The key chosen by the service is not read when it is not set.",
+				Optional:    true,
+			},
+			"client_side_encryption": {
+				Type:          schema.TypeList,
+				Description:   "Configuration of the client side envelope encryption. The content or source is encrypted locally with a random data key in AES/GCM mode, in chunks of 64KB each followed by a 16 bytes authentication tag. The data key is encrypted by the given key in AES/GCM mode and saved in the user metadata prefixed by client-side-encryption-, along with the algorithm, the chunk size and the nonce prefix in iv. The nonce of a chunk is the 7 bytes prefix, the 4 bytes big endian chunk index and 1 byte set to 1 for the last chunk.",
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"content_md5", "content_sha256", "content_crc32", "content_length"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Description:  "Base64 encoded AES key used to encrypt the data key, which must be 16, 24 or 32 bytes.",
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validateBOSClientSideEncryptionKey,
+						},
+					},
+				},
+			},
 
 			// compute attributes
 			"etag": {
 				Type:        schema.TypeString,
-				Description: "Etag generated of the object.",
+				Description: "Etag generated of the object. For the object uploaded in multiple parts, it is not the MD5 digest of the object content.",
 				Computed:    true,
 			},
 			"last_modified": {
@@ -195,20 +294,71 @@ func resourceBaiduCloudBucketObject() *schema.Resource {
 
 func resourceBaiduCloudBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
+	bosService := &BosService{client}
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
 	action := "Create bucket " + bucket + " object " + key
 
+	// changing the multipart upload settings only does not need to upload the object again
+	if !d.IsNewResource() && !bucketObjectContentChanged(d) {
+		return resourceBaiduCloudBucketObjectRead(d, meta)
+	}
+
+	headers := buildBosServerSideEncryptionHeaders(d.Get("server_side_encryption").(string), d.Get("kms_key_id").(string))
+
+	var masterKey []byte
+	if v, ok := d.GetOk("client_side_encryption"); ok {
+		masterKey, _ = base64.StdEncoding.DecodeString(v.([]interface{})[0].(map[string]interface{})["key"].(string))
+	}
+	var cseMeta map[string]string
+
 	var (
 		err  error
 		body *bce.Body
 	)
 	if source, ok := d.GetOk("source"); ok {
-		body, err = bce.NewBodyFromFile(source.(string))
+		sourceFile := source.(string)
+		if masterKey != nil {
+			sourceFile, cseMeta, err = encryptBucketObjectSourceFile(masterKey, sourceFile)
+			if err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_object", action, BCESDKGoERROR)
+			}
+			defer os.Remove(sourceFile)
+		}
+
+		fileInfo, errStat := os.Stat(sourceFile)
+		if errStat != nil {
+			return WrapErrorf(errStat, DefaultErrorMsg, "baiducloud_bos_bucket_object", action, BCESDKGoERROR)
+		}
+
+		if fileInfo.Size() >= int64(d.Get("multipart_threshold_in_mb").(int))*bos.MULTIPART_ALIGN {
+			if err := checkBucketObjectSourceDigest(d, sourceFile); err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_object", action, BCESDKGoERROR)
+			}
+
+			initArgs, completeArgs := buildBaiduCloudBucketObjectMultipartArgs(d)
+			completeArgs.UserMeta = mergeBucketObjectUserMeta(completeArgs.UserMeta, cseMeta)
+			err = bosService.MultipartUploadObject(bucket, key, sourceFile, d.Get("content_type").(string),
+				int64(d.Get("multipart_part_size_in_mb").(int))*bos.MULTIPART_ALIGN, d.Get("multipart_concurrency").(int),
+				initArgs, completeArgs, headers)
+			if err != nil {
+				return err
+			}
+		} else {
+			body, err = bce.NewBodyFromFile(sourceFile)
+		}
 	} else if content, ok := d.GetOk("content"); ok {
-		body, err = bce.NewBodyFromString(content.(string))
+		if masterKey != nil {
+			encrypted := &bytes.Buffer{}
+			cseMeta, err = encryptBucketObjectContent(masterKey, strings.NewReader(content.(string)), encrypted)
+			if err == nil {
+				body, err = bce.NewBodyFromBytes(encrypted.Bytes())
+			}
+		} else {
+			body, err = bce.NewBodyFromString(content.(string))
+		}
 	} else {
 		err = fmt.Errorf("The source and content cannot be empty at the same time.")
 	}
@@ -216,13 +366,17 @@ func resourceBaiduCloudBucketObjectPut(d *schema.ResourceData, meta interface{})
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_object", action, BCESDKGoERROR)
 	}
 
-	args := buildBaiduCloudBucketObjectArgs(d)
-	_, err = client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
-		return bosClient.PutObject(bucket, key, body, args)
-	})
-	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_object", action, BCESDKGoERROR)
+	if body != nil {
+		args := buildBaiduCloudBucketObjectArgs(d)
+		args.UserMeta = mergeBucketObjectUserMeta(args.UserMeta, cseMeta)
+		_, err = client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+			return api.PutObject(&bosRequestClient{Client: bosClient, headers: headers}, bucket, key, body, args)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_object", action, BCESDKGoERROR)
+		}
 	}
+
 	d.SetId(key)
 
 	cannedAcl, ok := d.GetOk("acl")
@@ -248,27 +402,38 @@ func resourceBaiduCloudBucketObjectRead(d *schema.ResourceData, meta interface{}
 	action := "Query bucket " + bucket + " object " + key
 
 	// read bos bucket object meta
-	raw, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
-		return bosClient.GetObjectMeta(bucket, key)
-	})
+	result, headers, err := bosService.GetObjectMetaWithHeaders(bucket, key)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_object", action, BCESDKGoERROR)
 	}
 
-	result, _ := raw.(*api.GetObjectMetaResult)
 	d.Set("cache_control", result.CacheControl)
 	d.Set("content_disposition", result.ContentDisposition)
-	d.Set("content_md5", result.ContentMD5)
+	// the object uploaded in multiple parts has no content md5, keep the one verified before uploading
+	if result.ContentMD5 != "" {
+		d.Set("content_md5", result.ContentMD5)
+	}
 	d.Set("content_type", result.ContentType)
 	d.Set("content_length", result.ContentLength)
 	d.Set("expires", result.Expires)
-	d.Set("user_meta", result.UserMeta)
+	// the metadata of the client side encryption is not managed by user_meta
+	userMeta := make(map[string]string)
+	for k, v := range result.UserMeta {
+		if !strings.HasPrefix(strings.ToLower(k), BOS_CLIENT_SIDE_ENCRYPTION_META_PREFIX) {
+			userMeta[k] = v
+		}
+	}
+	d.Set("user_meta", userMeta)
 	d.Set("content_sha256", result.ContentSha256)
 	d.Set("content_crc32", result.ContentCrc32)
 	d.Set("storage_class", result.StorageClass)
 	d.Set("etag", result.ETag)
 	d.Set("last_modified", result.LastModified)
 	d.Set("content_encoding", result.ContentEncoding)
+	d.Set("server_side_encryption", headers[BOS_HEADER_SERVER_SIDE_ENCRYPTION])
+	if d.Get("kms_key_id").(string) != "" {
+		d.Set("kms_key_id", headers[BOS_HEADER_SERVER_SIDE_ENCRYPTION_KMS_KEY_ID])
+	}
 
 	// read bos bucket object acl
 	acl, err := bosService.resourceBaiduCloudBucketObjectReadAcl(bucket, key)
@@ -280,6 +445,10 @@ func resourceBaiduCloudBucketObjectRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+func resourceBaiduCloudBucketObjectCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	return customizeBosKmsKeyIdDiff(d, "server_side_encryption")
+}
+
 func resourceBaiduCloudBucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
 
@@ -341,3 +510,267 @@ func buildBaiduCloudBucketObjectArgs(d *schema.ResourceData) *api.PutObjectArgs
 
 	return args
 }
+
+func buildBaiduCloudBucketObjectMultipartArgs(d *schema.ResourceData) (*api.InitiateMultipartUploadArgs, *api.CompleteMultipartUploadArgs) {
+	initArgs := &api.InitiateMultipartUploadArgs{}
+	completeArgs := &api.CompleteMultipartUploadArgs{}
+
+	if val, ok := d.GetOk("cache_control"); ok {
+		initArgs.CacheControl = val.(string)
+	}
+	if val, ok := d.GetOk("content_disposition"); ok {
+		initArgs.ContentDisposition = val.(string)
+	}
+	if val, ok := d.GetOk("expires"); ok {
+		initArgs.Expires = val.(string)
+	}
+	if val, ok := d.GetOk("storage_class"); ok {
+		initArgs.StorageClass = val.(string)
+	}
+	if val, ok := d.GetOk("user_meta"); ok {
+		raw := val.(map[string]interface{})
+		meta := make(map[string]string, len(raw))
+		for k, v := range raw {
+			meta[k] = v.(string)
+		}
+		completeArgs.UserMeta = meta
+	}
+	if val, ok := d.GetOk("content_crc32"); ok {
+		completeArgs.ContentCrc32 = val.(string)
+	}
+
+	return initArgs, completeArgs
+}
+
+// checkBucketObjectSourceDigest verifies content_md5 and content_sha256 against the source file, which can not be
+// verified by BOS when the object is uploaded in multiple parts
+func checkBucketObjectSourceDigest(d *schema.ResourceData, source string) error {
+	contentMD5, hasMD5 := d.GetOk("content_md5")
+	contentSha256, hasSha256 := d.GetOk("content_sha256")
+	if !hasMD5 && !hasSha256 {
+		return nil
+	}
+
+	file, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	md5Hash, sha256Hash := md5.New(), sha256.New()
+	if _, err := io.Copy(io.MultiWriter(md5Hash, sha256Hash), file); err != nil {
+		return err
+	}
+
+	if v := base64.StdEncoding.EncodeToString(md5Hash.Sum(nil)); hasMD5 && v != contentMD5.(string) {
+		return fmt.Errorf("content_md5 %s does not match the md5 %s of source %s", contentMD5.(string), v, source)
+	}
+	if v := hex.EncodeToString(sha256Hash.Sum(nil)); hasSha256 && !strings.EqualFold(v, contentSha256.(string)) {
+		return fmt.Errorf("content_sha256 %s does not match the sha256 %s of source %s", contentSha256.(string), v, source)
+	}
+
+	return nil
+}
+
+// encryptBucketObjectSourceFile encrypts the source file into a temporary file, which should be removed by the caller
+func encryptBucketObjectSourceFile(masterKey []byte, source string) (string, map[string]string, error) {
+	src, err := os.Open(source)
+	if err != nil {
+		return "", nil, err
+	}
+	defer src.Close()
+
+	dst, err := ioutil.TempFile("", "bos-object-")
+	if err != nil {
+		return "", nil, err
+	}
+	defer dst.Close()
+
+	meta, err := encryptBucketObjectContent(masterKey, src, dst)
+	if err != nil {
+		os.Remove(dst.Name())
+		return "", nil, err
+	}
+
+	return dst.Name(), meta, nil
+}
+
+// encryptBucketObjectContent encrypts the content with a random data key in chunked AES/GCM mode, and returns the user
+// metadata carrying the nonce prefix, the chunk size and the data key encrypted by the master key in AES/GCM mode.
+// Each chunk of BOS_CLIENT_SIDE_ENCRYPTION_CHUNK_SIZE bytes is sealed on its own with the nonce made of the prefix,
+// the chunk index and a flag marking the last chunk, so that the chunks can not be reordered, dropped or truncated
+// without failing the authentication.
+func encryptBucketObjectContent(masterKey []byte, src io.Reader, dst io.Writer) (map[string]string, error) {
+	dataKey := make([]byte, 32)
+	noncePrefix := make([]byte, bosClientSideEncryptionNoncePrefixSize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(noncePrefix); err != nil {
+		return nil, err
+	}
+
+	gcm, err := newBucketObjectGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	err = transformBucketObjectChunks(src, dst, BOS_CLIENT_SIDE_ENCRYPTION_CHUNK_SIZE, func(index uint32, chunk []byte, last bool) ([]byte, error) {
+		return gcm.Seal(nil, bucketObjectChunkNonce(noncePrefix, index, last), chunk, nil), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	masterGCM, err := newBucketObjectGCM(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, masterGCM.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	encryptedKey := masterGCM.Seal(nonce, nonce, dataKey, nil)
+
+	return map[string]string{
+		BOS_CLIENT_SIDE_ENCRYPTION_META_PREFIX + "algorithm":     BOS_CLIENT_SIDE_ENCRYPTION_ALGORITHM,
+		BOS_CLIENT_SIDE_ENCRYPTION_META_PREFIX + "chunk-size":    strconv.Itoa(BOS_CLIENT_SIDE_ENCRYPTION_CHUNK_SIZE),
+		BOS_CLIENT_SIDE_ENCRYPTION_META_PREFIX + "iv":            base64.StdEncoding.EncodeToString(noncePrefix),
+		BOS_CLIENT_SIDE_ENCRYPTION_META_PREFIX + "key":           base64.StdEncoding.EncodeToString(encryptedKey),
+		BOS_CLIENT_SIDE_ENCRYPTION_META_PREFIX + "key-algorithm": BOS_CLIENT_SIDE_ENCRYPTION_KEY_ALGORITHM,
+	}, nil
+}
+
+// decryptBucketObjectContent decrypts the content encrypted by encryptBucketObjectContent with the user metadata
+// saved along with it
+func decryptBucketObjectContent(masterKey []byte, userMeta map[string]string, src io.Reader, dst io.Writer) error {
+	meta := make(map[string]string, len(userMeta))
+	for k, v := range userMeta {
+		meta[strings.TrimPrefix(strings.ToLower(k), BOS_CLIENT_SIDE_ENCRYPTION_META_PREFIX)] = v
+	}
+	if meta["algorithm"] != BOS_CLIENT_SIDE_ENCRYPTION_ALGORITHM || meta["key-algorithm"] != BOS_CLIENT_SIDE_ENCRYPTION_KEY_ALGORITHM {
+		return fmt.Errorf("unsupported client side encryption algorithm %s with key algorithm %s",
+			meta["algorithm"], meta["key-algorithm"])
+	}
+	chunkSize, err := strconv.Atoi(meta["chunk-size"])
+	if err != nil || chunkSize <= 0 {
+		return fmt.Errorf("invalid client side encryption chunk size %s", meta["chunk-size"])
+	}
+	noncePrefix, err := base64.StdEncoding.DecodeString(meta["iv"])
+	if err != nil || len(noncePrefix) != bosClientSideEncryptionNoncePrefixSize {
+		return fmt.Errorf("invalid client side encryption iv %s", meta["iv"])
+	}
+	encryptedKey, err := base64.StdEncoding.DecodeString(meta["key"])
+	if err != nil {
+		return fmt.Errorf("invalid client side encryption key: %s", err)
+	}
+
+	masterGCM, err := newBucketObjectGCM(masterKey)
+	if err != nil {
+		return err
+	}
+	if len(encryptedKey) < masterGCM.NonceSize() {
+		return fmt.Errorf("invalid client side encryption key")
+	}
+	dataKey, err := masterGCM.Open(nil, encryptedKey[:masterGCM.NonceSize()], encryptedKey[masterGCM.NonceSize():], nil)
+	if err != nil {
+		return fmt.Errorf("decrypt the data key error: %s", err)
+	}
+
+	gcm, err := newBucketObjectGCM(dataKey)
+	if err != nil {
+		return err
+	}
+	return transformBucketObjectChunks(src, dst, chunkSize+gcm.Overhead(), func(index uint32, chunk []byte, last bool) ([]byte, error) {
+		plain, err := gcm.Open(nil, bucketObjectChunkNonce(noncePrefix, index, last), chunk, nil)
+		if err != nil {
+			return nil, fmt.Errorf("decrypt chunk %d error: %s", index, err)
+		}
+		return plain, nil
+	})
+}
+
+func newBucketObjectGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// bucketObjectChunkNonce builds the 12 bytes gcm nonce of a chunk from the 7 bytes prefix, the 4 bytes big endian
+// chunk index and the 1 byte last chunk flag
+func bucketObjectChunkNonce(prefix []byte, index uint32, last bool) []byte {
+	nonce := make([]byte, bosClientSideEncryptionNoncePrefixSize+5)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[bosClientSideEncryptionNoncePrefixSize:], index)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+
+	return nonce
+}
+
+// transformBucketObjectChunks reads src in chunks of chunkSize bytes and writes the transformed chunks to dst. A chunk
+// is the last one if it is shorter than chunkSize or followed by nothing, and an empty src makes one empty last chunk.
+func transformBucketObjectChunks(src io.Reader, dst io.Writer, chunkSize int,
+	transform func(index uint32, chunk []byte, last bool) ([]byte, error)) error {
+	buf := make([]byte, chunkSize)
+	next := make([]byte, chunkSize)
+
+	n, err := io.ReadFull(src, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+	for index := uint32(0); ; index++ {
+		last := n < chunkSize
+		m := 0
+		if !last {
+			m, err = io.ReadFull(src, next)
+			if err == io.EOF {
+				last = true
+			} else if err != nil && err != io.ErrUnexpectedEOF {
+				return err
+			}
+		}
+
+		out, err := transform(index, buf[:n], last)
+		if err != nil {
+			return err
+		}
+		if _, err := dst.Write(out); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+		buf, next, n = next, buf, m
+	}
+}
+
+func mergeBucketObjectUserMeta(userMeta, extra map[string]string) map[string]string {
+	if len(extra) == 0 {
+		return userMeta
+	}
+	if userMeta == nil {
+		userMeta = make(map[string]string, len(extra))
+	}
+	for k, v := range extra {
+		userMeta[k] = v
+	}
+
+	return userMeta
+}
+
+func bucketObjectContentChanged(d *schema.ResourceData) bool {
+	for key := range resourceBaiduCloudBucketObject().Schema {
+		if stringInSlice([]string{"multipart_threshold_in_mb", "multipart_part_size_in_mb", "multipart_concurrency"}, key) {
+			continue
+		}
+		if d.HasChange(key) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,12 +1,18 @@
 package baiducloud
 
 import (
+	"bytes"
+	"encoding/base64"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"os"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/baidubce/bce-sdk-go/services/bos"
+	"github.com/baidubce/bce-sdk-go/services/bos/api"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
@@ -129,6 +135,115 @@ func TestAccBaiduCloudBosBucketObject(t *testing.T) {
 	})
 }
 
+//lintignore:AT003
+func TestAccBaiduCloudBosBucketObject_Multipart(t *testing.T) {
+	// 3MB source file, which is uploaded in 3 parts
+	file, err := ioutil.TempFile("", testAccBosBucketObjectResourceAttrName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write([]byte(strings.Repeat("0123456789abcdef", 3*(1<<16)))); err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBosBucketObjectDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBosBucketObjectMultipartConfig(file.Name(), 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosBucketObjectResourceName),
+					resource.TestCheckResourceAttr(testAccBosBucketObjectResourceName, "content_length", "3145728"),
+					resource.TestCheckResourceAttr(testAccBosBucketObjectResourceName, "user_meta.Metaa", "metaA"),
+					resource.TestCheckResourceAttrSet(testAccBosBucketObjectResourceName, "etag"),
+				),
+			},
+			{
+				Config: testAccBosBucketObjectMultipartConfig(file.Name(), 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosBucketObjectResourceName),
+					resource.TestCheckResourceAttr(testAccBosBucketObjectResourceName, "multipart_concurrency", "3"),
+					resource.TestCheckResourceAttr(testAccBosBucketObjectResourceName, "content_length", "3145728"),
+				),
+			},
+		},
+	})
+}
+
+//lintignore:AT003
+func TestAccBaiduCloudBosBucketObject_Encryption(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBosBucketObjectDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBosBucketObjectEncryptionConfigInvalidKmsKeyId(),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("kms_key_id test-kms-key is only valid when server_side_encryption is KMS"),
+			},
+			{
+				Config: testAccBosBucketObjectEncryptionConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosBucketObjectResourceName),
+					resource.TestCheckResourceAttr(testAccBosBucketObjectResourceName, "server_side_encryption", "AES256"),
+					resource.TestCheckResourceAttr(testAccBosBucketObjectResourceName, "client_side_encryption.#", "1"),
+					resource.TestCheckResourceAttr(testAccBosBucketObjectResourceName, "user_meta.%", "1"),
+					// "hello world" sealed in one chunk with a 16 bytes tag
+					resource.TestCheckResourceAttr(testAccBosBucketObjectResourceName, "content_length", "27"),
+					testAccCheckBosBucketObjectDecryptedContent(testAccBosBucketObjectResourceName,
+						"MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=", "hello world"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckBosBucketObjectDecryptedContent gets the client side encrypted object and checks that it is decrypted
+// back to the content with the key
+func testAccCheckBosBucketObjectDecryptedContent(name, key, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("can't find bos bucket object: %s", name)
+		}
+		client := testAccProvider.Meta().(*connectivity.BaiduClient)
+
+		raw, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+			return bosClient.GetObject(rs.Primary.Attributes["bucket"], rs.Primary.ID, nil)
+		})
+		if err != nil {
+			return WrapError(err)
+		}
+		result := raw.(*api.GetObjectResult)
+		defer result.Body.Close()
+
+		masterKey, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return err
+		}
+		decrypted := &bytes.Buffer{}
+		if err := decryptBucketObjectContent(masterKey, result.UserMeta, result.Body, decrypted); err != nil {
+			return err
+		}
+		if decrypted.String() != content {
+			return fmt.Errorf("decrypted content of %s is %q, expected %q", name, decrypted.String(), content)
+		}
+
+		return nil
+	}
+}
+
 func testAccBosBucketObjectDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.BaiduClient)
 
@@ -195,3 +310,57 @@ resource "baiducloud_bos_bucket_object" "default" {
 }
 `, testAccBosBucketResourceAttrName, testAccBosBucketObjectResourceAttrName)
 }
+
+func testAccBosBucketObjectMultipartConfig(source string, concurrency int) string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%s"
+}
+
+resource "baiducloud_bos_bucket_object" "default" {
+  bucket                    = baiducloud_bos_bucket.default.bucket
+  key                       = "%s"
+  source                    = "%s"
+  multipart_threshold_in_mb = 1
+  multipart_part_size_in_mb = 1
+  multipart_concurrency     = %d
+  user_meta = {
+    Metaa = "metaA"
+  }
+}
+`, testAccBosBucketResourceAttrName, testAccBosBucketObjectResourceAttrName, source, concurrency)
+}
+
+func testAccBosBucketObjectEncryptionConfig() string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%s"
+}
+
+resource "baiducloud_bos_bucket_object" "default" {
+  bucket                 = baiducloud_bos_bucket.default.bucket
+  key                    = "%s"
+  content                = "hello world"
+  server_side_encryption = "AES256"
+  user_meta = {
+    Metaa = "metaA"
+  }
+
+  client_side_encryption {
+    key = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+  }
+}
+`, testAccBosBucketResourceAttrName, testAccBosBucketObjectResourceAttrName)
+}
+
+func testAccBosBucketObjectEncryptionConfigInvalidKmsKeyId() string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket_object" "default" {
+  bucket                 = "%s"
+  key                    = "%s"
+  content                = "hello world"
+  server_side_encryption = "AES256"
+  kms_key_id             = "test-kms-key"
+}
+`, testAccBosBucketResourceAttrName, testAccBosBucketObjectResourceAttrName)
+}
@@ -0,0 +1,409 @@
+/*
+Provide a resource to sync a local directory to a BOS bucket under a key prefix. Only the files whose content changed
+are uploaded, and the objects of the files removed from the directory are deleted.
+
+Example Usage
+
+```hcl
+resource "baiducloud_bos_bucket_objects_sync" "default" {
+  bucket     = "my-bucket"
+  source_dir = "./public"
+  key_prefix = "site/"
+  excludes   = ["*.map", ".git"]
+
+  cache_control {
+    pattern = "*.html"
+    value   = "no-cache"
+  }
+  cache_control {
+    pattern = "assets/*"
+    value   = "max-age=31536000"
+  }
+}
+```
+*/
+package baiducloud
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/services/bos/api"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudBosBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudBosBucketObjectsSyncCreate,
+		Read:   resourceBaiduCloudBosBucketObjectsSyncRead,
+		Update: resourceBaiduCloudBosBucketObjectsSyncUpdate,
+		Delete: resourceBaiduCloudBosBucketObjectsSyncDelete,
+
+		CustomizeDiff: resourceBaiduCloudBosBucketObjectsSyncCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Description: "Name of the bucket to sync the directory to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"source_dir": {
+				Type:        schema.TypeString,
+				Description: "Path of the local directory to be synced.",
+				Required:    true,
+			},
+			"key_prefix": {
+				Type:        schema.TypeString,
+				Description: "Prefix of the object keys, the object key is the prefix followed by the slash separated path of the file relative to source_dir.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"excludes": {
+				Type:        schema.TypeSet,
+				Description: "Glob patterns of the files and directories not to be synced, which are matched against the relative path, the name and the parent directories of the file.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"cache_control": {
+				Type:        schema.TypeList,
+				Description: "Cache control of the objects whose relative path, name or parent directory matches the pattern, the first matched one takes effect. Changing it uploads all the files again.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:        schema.TypeString,
+							Description: "Glob pattern of the files.",
+							Required:    true,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "Cache-Control of the matched objects, such as no-cache or max-age=3600.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Description:  "Storage class of the objects, which can be COLD, STANDARD_IA, STANDARD or ARCHIVE. Changing it uploads all the files again.",
+				Optional:     true,
+				ValidateFunc: validateBOSBucketStorageClass(),
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Description:  "Number of files uploaded concurrently, support between 1 and 100. Default to 10.",
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			// compute attributes
+			"objects": {
+				Type:        schema.TypeMap,
+				Description: "Synced objects, mapping the object key to the MD5 digest in hex of its content.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudBosBucketObjectsSyncCreate(d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	// set the id before syncing, so that the objects uploaded are kept in state and can be deleted
+	// even if the sync fails halfway
+	d.SetId(strings.Join([]string{bucket, keyPrefix}, COLON_SEPARATED))
+	if err := syncBosBucketObjects(d, meta, true); err != nil {
+		return err
+	}
+
+	return resourceBaiduCloudBosBucketObjectsSyncRead(d, meta)
+}
+
+func resourceBaiduCloudBosBucketObjectsSyncRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	bosService := &BosService{client}
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	action := "Query bucket " + bucket + " synced objects " + keyPrefix
+
+	objects, err := bosService.ListAllObjects(bucket, keyPrefix)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_objects_sync", action, BCESDKGoERROR)
+	}
+
+	etags := make(map[string]string, len(objects))
+	for _, object := range objects {
+		etags[object.Key] = strings.ToLower(strings.Trim(object.ETag, "\""))
+	}
+
+	// drop the objects deleted and record the etag of the objects changed outside,
+	// so that they are uploaded again on the next apply
+	synced := make(map[string]interface{})
+	for key, hash := range d.Get("objects").(map[string]interface{}) {
+		etag, ok := etags[key]
+		if !ok {
+			continue
+		}
+		if etag != hash.(string) {
+			synced[key] = etag
+			continue
+		}
+		synced[key] = hash
+	}
+	d.Set("objects", synced)
+
+	return nil
+}
+
+func resourceBaiduCloudBosBucketObjectsSyncUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := syncBosBucketObjects(d, meta, d.HasChange("cache_control") || d.HasChange("storage_class")); err != nil {
+		return err
+	}
+
+	return resourceBaiduCloudBosBucketObjectsSyncRead(d, meta)
+}
+
+func resourceBaiduCloudBosBucketObjectsSyncDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	bosService := &BosService{client}
+
+	bucket := d.Get("bucket").(string)
+	keys := make([]string, 0)
+	for key := range d.Get("objects").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if err := bosService.DeleteObjects(bucket, keys); err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+func resourceBaiduCloudBosBucketObjectsSyncCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("key_prefix") || !d.NewValueKnown("excludes") {
+		return d.SetNewComputed("objects")
+	}
+
+	files, err := listBosSyncFiles(d.Get("source_dir").(string), d.Get("key_prefix").(string),
+		expandStringSet(d.Get("excludes").(*schema.Set)))
+	if err != nil {
+		return err
+	}
+
+	objects := make(map[string]interface{}, len(files))
+	for key, fileName := range files {
+		hash, err := fileContentMD5Hex(fileName)
+		if err != nil {
+			return err
+		}
+		objects[key] = hash
+	}
+
+	old := d.Get("objects").(map[string]interface{})
+	changed := len(old) != len(objects)
+	for key, hash := range objects {
+		if old[key] != hash {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	return d.SetNew("objects", objects)
+}
+
+// syncBosBucketObjects uploads the files changed or all the files if uploadAll is set, and deletes the objects
+// whose files are removed. If it fails halfway, the objects uploaded or not deleted yet are recorded, so that
+// the next apply picks up from there.
+func syncBosBucketObjects(d *schema.ResourceData, meta interface{}, uploadAll bool) error {
+	client := meta.(*connectivity.BaiduClient)
+	bosService := &BosService{client}
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	files, err := listBosSyncFiles(d.Get("source_dir").(string), keyPrefix,
+		expandStringSet(d.Get("excludes").(*schema.Set)))
+	if err != nil {
+		return WrapError(err)
+	}
+
+	// hash the files again as they may have changed since plan
+	synced := make(map[string]interface{}, len(files))
+	for key, fileName := range files {
+		hash, err := fileContentMD5Hex(fileName)
+		if err != nil {
+			return WrapError(err)
+		}
+		synced[key] = hash
+	}
+
+	o, _ := d.GetChange("objects")
+	oldObjects := o.(map[string]interface{})
+
+	uploads := make(map[string]string)
+	for key, fileName := range files {
+		if hash, ok := oldObjects[key]; uploadAll || !ok || hash != synced[key] {
+			uploads[key] = fileName
+		}
+	}
+
+	cacheControls := d.Get("cache_control").([]interface{})
+	storageClass := d.Get("storage_class").(string)
+	uploaded, err := bosService.PutObjectsFromFiles(bucket, uploads, d.Get("concurrency").(int), func(key, fileName string) *api.PutObjectArgs {
+		rel := strings.TrimPrefix(key, keyPrefix)
+		args := &api.PutObjectArgs{
+			ContentType:  mime.TypeByExtension(filepath.Ext(fileName)),
+			StorageClass: storageClass,
+		}
+		for _, raw := range cacheControls {
+			cacheControl := raw.(map[string]interface{})
+			if matchBosSyncPattern(cacheControl["pattern"].(string), rel) {
+				args.CacheControl = cacheControl["value"].(string)
+				break
+			}
+		}
+		return args
+	})
+	if err != nil {
+		// the objects not uploaded keep their old digest, so they are still seen as changed
+		partial := make(map[string]interface{}, len(oldObjects)+len(uploaded))
+		for key, hash := range oldObjects {
+			partial[key] = hash
+		}
+		for _, key := range uploaded {
+			partial[key] = synced[key]
+		}
+		d.Set("objects", partial)
+		return err
+	}
+
+	removed := make([]string, 0)
+	for key := range oldObjects {
+		if _, ok := files[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+	if len(removed) > 0 {
+		if err := bosService.DeleteObjects(bucket, removed); err != nil {
+			// the objects deleted are dropped by the next read
+			for _, key := range removed {
+				synced[key] = oldObjects[key]
+			}
+			d.Set("objects", synced)
+			return err
+		}
+	}
+
+	d.Set("objects", synced)
+
+	return nil
+}
+
+// listBosSyncFiles walks the directory and maps the object keys to the regular files not excluded
+func listBosSyncFiles(sourceDir, keyPrefix string, excludes []string) (map[string]string, error) {
+	files := make(map[string]string)
+
+	err := filepath.Walk(sourceDir, func(fileName string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(sourceDir, fileName)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+
+		for _, pattern := range excludes {
+			if matchBosSyncPattern(pattern, rel) {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+
+		if info.Mode().IsRegular() {
+			files[keyPrefix+rel] = fileName
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list files of source_dir %s error: %s", sourceDir, err)
+	}
+
+	return files, nil
+}
+
+// matchBosSyncPattern matches the pattern against the relative path, the name and the parent directories of a file,
+// so that a pattern matching a directory covers all the files under it
+func matchBosSyncPattern(pattern, rel string) bool {
+	if ok, _ := path.Match(pattern, path.Base(rel)); ok {
+		return true
+	}
+	for dir := rel; dir != "." && dir != "/"; dir = path.Dir(dir) {
+		if ok, _ := path.Match(pattern, dir); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func fileContentMD5Hex(fileName string) (string, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
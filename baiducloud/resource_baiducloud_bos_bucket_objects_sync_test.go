@@ -0,0 +1,128 @@
+package baiducloud
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/baidubce/bce-sdk-go/services/bos"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	testAccBosBucketObjectsSyncResourceType = "baiducloud_bos_bucket_objects_sync"
+	testAccBosBucketObjectsSyncResourceName = testAccBosBucketObjectsSyncResourceType + "." + BaiduCloudTestResourceName
+	testAccBosBucketObjectsSyncKeyPrefix    = BaiduCloudTestResourceAttrNamePrefix + "BosBucketObjectsSync/"
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBosBucketObjectsSync(t *testing.T) {
+	dir, err := ioutil.TempDir("", BaiduCloudTestResourceAttrNamePrefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeFile := func(name, content string) {
+		fileName := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(fileName), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(fileName, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("index.html", "<html>hello</html>")
+	writeFile("assets/app.js", "console.log('hello')")
+	writeFile("assets/app.js.map", "{}")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBosBucketObjectsSyncDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBosBucketObjectsSyncConfig(dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosBucketObjectsSyncResourceName),
+					resource.TestCheckResourceAttr(testAccBosBucketObjectsSyncResourceName, "objects.%", "2"),
+					resource.TestCheckResourceAttrSet(testAccBosBucketObjectsSyncResourceName, "objects."+testAccBosBucketObjectsSyncKeyPrefix+"index.html"),
+					resource.TestCheckResourceAttrSet(testAccBosBucketObjectsSyncResourceName, "objects."+testAccBosBucketObjectsSyncKeyPrefix+"assets/app.js"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeFile("index.html", "<html>hello again</html>")
+					if err := os.Remove(filepath.Join(dir, "assets", "app.js")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccBosBucketObjectsSyncConfig(dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosBucketObjectsSyncResourceName),
+					resource.TestCheckResourceAttr(testAccBosBucketObjectsSyncResourceName, "objects.%", "1"),
+					resource.TestCheckResourceAttr(testAccBosBucketObjectsSyncResourceName, "objects."+testAccBosBucketObjectsSyncKeyPrefix+"index.html", "3707cecfcaece142b627f0a10faf7573"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBosBucketObjectsSyncDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.BaiduClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccBosBucketObjectsSyncResourceType {
+			continue
+		}
+
+		for key := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "objects.") || key == "objects.%" {
+				continue
+			}
+
+			_, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+				return bosClient.GetObjectMeta(testAccBosBucketResourceAttrName, strings.TrimPrefix(key, "objects."))
+			})
+			if err != nil {
+				if IsExceptedErrors(err, []string{"Not Found"}) {
+					continue
+				}
+				return WrapError(err)
+			}
+			return WrapError(Error("BOS bucket synced object still exist"))
+		}
+	}
+
+	return nil
+}
+
+func testAccBosBucketObjectsSyncConfig(dir string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%s"
+}
+
+resource "baiducloud_bos_bucket_objects_sync" "default" {
+  bucket      = baiducloud_bos_bucket.default.bucket
+  source_dir  = "%s"
+  key_prefix  = "%s"
+  excludes    = ["*.map"]
+  concurrency = 2
+
+  cache_control {
+    pattern = "*.html"
+    value   = "no-cache"
+  }
+}
+`, testAccBosBucketResourceAttrName, filepath.ToSlash(dir), testAccBosBucketObjectsSyncKeyPrefix)
+}
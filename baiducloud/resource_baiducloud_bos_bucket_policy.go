@@ -0,0 +1,416 @@
+/*
+Provide a resource to manage the ACL policy document of a BOS Bucket, which grants permissions to principals on the
+bucket or the objects under a prefix, with optional ip address and referer conditions. The policy replaces the canned
+acl of the bucket, so do not set acl of baiducloud_bos_bucket at the same time.
+
+Example Usage
+
+```hcl
+resource "baiducloud_bos_bucket_policy" "default" {
+  bucket = "my-bucket"
+
+  policy = <<EOF
+{
+  "accessControlList": [
+    {
+      "grantee": [{"id": "*"}],
+      "permission": ["READ"],
+      "resource": ["my-bucket/public/*"],
+      "condition": {
+        "ipAddress": ["192.168.0.0/16"],
+        "referer": {
+          "stringLike": ["https://*.example.com/*"]
+        }
+      }
+    },
+    {
+      "grantee": [{"id": "c1ab0e1c8e8a4b9f9c3f3d3e2a1b0c9d"}],
+      "permission": ["FULL_CONTROL"]
+    }
+  ]
+}
+EOF
+}
+```
+
+Import
+
+BOS Bucket Policy can be imported by bucket name, e.g.
+
+```hcl
+$ terraform import baiducloud_bos_bucket_policy.default my-bucket
+```
+*/
+package baiducloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/services/bos"
+	"github.com/baidubce/bce-sdk-go/services/bos/api"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+// bosBucketPolicyPermissions are the permissions which can be granted by the policy, the coarse ones such as READ
+// cover a group of the fine-grained ones such as GetObject
+var bosBucketPolicyPermissions = []string{
+	"READ", "WRITE", "LIST", "MODIFY", "FULL_CONTROL",
+	"GetObject", "PutObject", "DeleteObject", "RestoreObject", "RenameObject", "GetObjectAcl", "PutObjectAcl",
+	"ListObjects", "ListParts", "ListMultipartUploads",
+	"GetBucketAcl", "PutBucketAcl", "GetBucketCors", "PutBucketCors", "GetBucketStyle", "PutBucketStyle",
+	"GetCopyRightProtection", "PutCopyRightProtection",
+}
+
+// bosBucketPolicy mirrors api.PutBucketAclArgs with all the fields omitted when empty, so that the marshaled
+// document is canonical and unknown fields can be rejected when decoding
+type bosBucketPolicy struct {
+	AccessControlList []bosBucketPolicyGrant `json:"accessControlList"`
+}
+
+type bosBucketPolicyGrant struct {
+	Grantee     []api.GranteeType         `json:"grantee"`
+	Permission  []string                  `json:"permission"`
+	Resource    []string                  `json:"resource,omitempty"`
+	NotResource []string                  `json:"notResource,omitempty"`
+	Condition   *bosBucketPolicyCondition `json:"condition,omitempty"`
+}
+
+type bosBucketPolicyCondition struct {
+	IpAddress []string                `json:"ipAddress,omitempty"`
+	Referer   *bosBucketPolicyReferer `json:"referer,omitempty"`
+}
+
+type bosBucketPolicyReferer struct {
+	StringLike   []string `json:"stringLike,omitempty"`
+	StringEquals []string `json:"stringEquals,omitempty"`
+}
+
+func resourceBaiduCloudBosBucketPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudBosBucketPolicyCreate,
+		Read:   resourceBaiduCloudBosBucketPolicyRead,
+		Update: resourceBaiduCloudBosBucketPolicyUpdate,
+		Delete: resourceBaiduCloudBosBucketPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceBaiduCloudBosBucketPolicyCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Description: "Name of the bucket.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Description:      "ACL policy document in json, the key order and whitespace of the document are ignored when comparing. The permission can be READ, WRITE, LIST, MODIFY, FULL_CONTROL or a fine-grained one such as GetObject and PutObject.",
+				Required:         true,
+				ValidateFunc:     validateBOSBucketPolicy,
+				DiffSuppressFunc: bosBucketPolicyDiffSuppressFunc,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudBosBucketPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+	action := "Create Bucket " + bucket + " Policy"
+
+	if err := putBaiduCloudBosBucketPolicy(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_policy", action, BCESDKGoERROR)
+	}
+	d.SetId(bucket)
+
+	return resourceBaiduCloudBosBucketPolicyRead(d, meta)
+}
+
+func resourceBaiduCloudBosBucketPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	bucket := d.Id()
+	action := "Query Bucket " + bucket + " Policy"
+
+	raw, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+		return bosClient.GetBucketAcl(bucket)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		if IsExceptedErrors(err, []string{"NoSuchBucket"}) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_policy", action, BCESDKGoERROR)
+	}
+
+	result, _ := raw.(*api.GetBucketAclResult)
+	configured, _ := parseBosBucketPolicy(d.Get("policy").(string))
+	policy, err := flattenBosBucketPolicy(result, configured)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_policy", action, BCESDKGoERROR)
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("policy", policy)
+
+	return nil
+}
+
+func resourceBaiduCloudBosBucketPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	action := "Update Bucket " + d.Id() + " Policy"
+
+	if d.HasChange("policy") {
+		if err := putBaiduCloudBosBucketPolicy(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_policy", action, BCESDKGoERROR)
+		}
+	}
+
+	return resourceBaiduCloudBosBucketPolicyRead(d, meta)
+}
+
+func resourceBaiduCloudBosBucketPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	bucket := d.Id()
+	action := "Delete Bucket " + bucket + " Policy"
+
+	// there is no api to delete the acl, reset it to private instead
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+			return nil, bosClient.PutBucketAclFromCanned(bucket, BOS_BUCKET_ACL_PRIVATE)
+		})
+		addDebug(action, bucket)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if IsExceptedErrors(err, []string{"NoSuchBucket"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_policy", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func resourceBaiduCloudBosBucketPolicyCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("bucket") || !d.NewValueKnown("policy") {
+		return nil
+	}
+
+	policy, err := parseBosBucketPolicy(d.Get("policy").(string))
+	if err != nil {
+		return err
+	}
+
+	// the resources must be the bucket itself or the objects in it
+	bucket := d.Get("bucket").(string)
+	for i, grant := range policy.AccessControlList {
+		for _, res := range append(append([]string{}, grant.Resource...), grant.NotResource...) {
+			if res != bucket && !strings.HasPrefix(res, bucket+"/") {
+				return fmt.Errorf("resource %s of grant %d in policy does not belong to bucket %s", res, i, bucket)
+			}
+		}
+	}
+
+	return nil
+}
+
+func putBaiduCloudBosBucketPolicy(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	bucket := d.Get("bucket").(string)
+	policy, err := normalizeBosBucketPolicy(d.Get("policy").(string))
+	if err != nil {
+		return err
+	}
+	action := "Put Bucket " + bucket + " Policy"
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		_, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+			return nil, bosClient.PutBucketAclFromString(bucket, policy)
+		})
+		addDebug(action, policy)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+// parseBosBucketPolicy decodes the policy document strictly and checks the grants
+func parseBosBucketPolicy(document string) (*bosBucketPolicy, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(document)))
+	decoder.DisallowUnknownFields()
+
+	policy := &bosBucketPolicy{}
+	if err := decoder.Decode(policy); err != nil {
+		return nil, fmt.Errorf("invalid policy document: %s", err)
+	}
+
+	if len(policy.AccessControlList) == 0 {
+		return nil, fmt.Errorf("accessControlList of policy can not be empty")
+	}
+	for i, grant := range policy.AccessControlList {
+		if len(grant.Grantee) == 0 {
+			return nil, fmt.Errorf("grantee of grant %d in policy can not be empty", i)
+		}
+		for _, grantee := range grant.Grantee {
+			if grantee.Id == "" {
+				return nil, fmt.Errorf("grantee id of grant %d in policy can not be empty", i)
+			}
+		}
+		if len(grant.Permission) == 0 {
+			return nil, fmt.Errorf("permission of grant %d in policy can not be empty", i)
+		}
+		for _, permission := range grant.Permission {
+			if permission == "" {
+				return nil, fmt.Errorf("permission of grant %d in policy can not be empty", i)
+			}
+			if !stringInSlice(bosBucketPolicyPermissions, permission) {
+				return nil, fmt.Errorf("permission %s of grant %d in policy is not valid, expected one of %s",
+					permission, i, strings.Join(bosBucketPolicyPermissions, ", "))
+			}
+		}
+		if len(grant.Resource) > 0 && len(grant.NotResource) > 0 {
+			return nil, fmt.Errorf("resource and notResource of grant %d in policy can not be set at the same time", i)
+		}
+		if grant.Condition != nil {
+			for _, ip := range grant.Condition.IpAddress {
+				if !isBosBucketPolicyIpAddress(ip) {
+					return nil, fmt.Errorf("ipAddress %s of grant %d in policy is not a valid ip, cidr or ip with wildcard", ip, i)
+				}
+			}
+		}
+	}
+
+	return policy, nil
+}
+
+// normalizeBosBucketPolicy returns the canonical json of the policy document
+func normalizeBosBucketPolicy(document string) (string, error) {
+	policy, err := parseBosBucketPolicy(document)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := json.Marshal(policy)
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
+
+// flattenBosBucketPolicy converts the bucket acl to the canonical policy document, the default full control grant of
+// the owner is dropped unless it is configured
+func flattenBosBucketPolicy(result *api.GetBucketAclResult, configured *bosBucketPolicy) (string, error) {
+	policy := &bosBucketPolicy{
+		AccessControlList: make([]bosBucketPolicyGrant, 0),
+	}
+	if result == nil {
+		return "", fmt.Errorf("empty bucket acl")
+	}
+
+	for _, g := range result.AccessControlList {
+		grant := bosBucketPolicyGrant{
+			Grantee:     g.Grantee,
+			Permission:  g.Permission,
+			Resource:    g.Resource,
+			NotResource: g.NotResource,
+		}
+		if len(g.Condition.IpAddress) > 0 || len(g.Condition.Referer.StringLike) > 0 || len(g.Condition.Referer.StringEquals) > 0 {
+			grant.Condition = &bosBucketPolicyCondition{
+				IpAddress: g.Condition.IpAddress,
+			}
+			if len(g.Condition.Referer.StringLike) > 0 || len(g.Condition.Referer.StringEquals) > 0 {
+				grant.Condition.Referer = &bosBucketPolicyReferer{
+					StringLike:   g.Condition.Referer.StringLike,
+					StringEquals: g.Condition.Referer.StringEquals,
+				}
+			}
+		}
+
+		if isBosBucketOwnerGrant(grant, result.Owner.Id) && !bosBucketPolicyHasGrant(configured, grant) {
+			continue
+		}
+		policy.AccessControlList = append(policy.AccessControlList, grant)
+	}
+
+	document, err := json.Marshal(policy)
+	if err != nil {
+		return "", err
+	}
+
+	return string(document), nil
+}
+
+func isBosBucketOwnerGrant(grant bosBucketPolicyGrant, ownerId string) bool {
+	return len(grant.Grantee) == 1 && grant.Grantee[0].Id == ownerId &&
+		reflect.DeepEqual(grant.Permission, []string{"FULL_CONTROL"}) &&
+		len(grant.Resource) == 0 && len(grant.NotResource) == 0 && grant.Condition == nil
+}
+
+func bosBucketPolicyHasGrant(policy *bosBucketPolicy, grant bosBucketPolicyGrant) bool {
+	if policy == nil {
+		return false
+	}
+	for _, g := range policy.AccessControlList {
+		if reflect.DeepEqual(g, grant) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isBosBucketPolicyIpAddress(ip string) bool {
+	if net.ParseIP(ip) != nil {
+		return true
+	}
+	if _, _, err := net.ParseCIDR(ip); err == nil {
+		return true
+	}
+
+	// wildcard form such as 192.168.1.*
+	if strings.HasSuffix(ip, ".*") {
+		parts := strings.Split(strings.TrimSuffix(ip, ".*"), ".")
+		for len(parts) < 4 {
+			parts = append(parts, "0")
+		}
+		return len(parts) == 4 && net.ParseIP(strings.Join(parts, ".")) != nil
+	}
+
+	return false
+}
@@ -0,0 +1,153 @@
+package baiducloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccBosBucketPolicyResourceType = "baiducloud_bos_bucket_policy"
+	testAccBosBucketPolicyResourceName = testAccBosBucketPolicyResourceType + "." + BaiduCloudTestResourceName
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBosBucketPolicy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBosBucketDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBosBucketPolicyConfigInvalid(),
+				ExpectError: regexp.MustCompile("does not belong to bucket"),
+			},
+			{
+				Config:      testAccBosBucketPolicyConfigInvalidPermission(),
+				ExpectError: regexp.MustCompile("permission GetObjects of grant 0 in policy is not valid"),
+			},
+			{
+				Config: testAccBosBucketPolicyConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosBucketPolicyResourceName),
+					resource.TestCheckResourceAttr(testAccBosBucketPolicyResourceName, "bucket", testAccBosBucketResourceAttrName),
+					resource.TestMatchResourceAttr(testAccBosBucketPolicyResourceName, "policy", regexp.MustCompile(`"ipAddress":\["192.168.0.0/16"\]`)),
+					resource.TestCheckResourceAttr(testAccBosBucketResourceName, "acl", "private"),
+				),
+			},
+			{
+				// the bucket leaving acl unset must not put the canned acl back over the policy
+				Config:   testAccBosBucketPolicyConfig(),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      testAccBosBucketPolicyResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccBosBucketPolicyConfigReformatted(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccBosBucketPolicyConfig() string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%[1]s"
+}
+
+resource "baiducloud_bos_bucket_policy" "default" {
+  bucket = baiducloud_bos_bucket.default.bucket
+
+  policy = <<EOF
+{
+  "accessControlList": [
+    {
+      "grantee": [{"id": "*"}],
+      "permission": ["READ"],
+      "resource": ["%[1]s/public/*"],
+      "condition": {
+        "ipAddress": ["192.168.0.0/16"],
+        "referer": {
+          "stringLike": ["https://*.example.com/*"]
+        }
+      }
+    }
+  ]
+}
+EOF
+}
+`, testAccBosBucketResourceAttrName)
+}
+
+func testAccBosBucketPolicyConfigReformatted() string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%[1]s"
+}
+
+resource "baiducloud_bos_bucket_policy" "default" {
+  bucket = baiducloud_bos_bucket.default.bucket
+
+  policy = jsonencode({
+    accessControlList = [{
+      condition = {
+        referer   = { stringLike = ["https://*.example.com/*"] }
+        ipAddress = ["192.168.0.0/16"]
+      }
+      resource   = ["%[1]s/public/*"]
+      permission = ["READ"]
+      grantee    = [{ id = "*" }]
+    }]
+  })
+}
+`, testAccBosBucketResourceAttrName)
+}
+
+func testAccBosBucketPolicyConfigInvalid() string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket_policy" "default" {
+  bucket = "%s"
+
+  policy = <<EOF
+{
+  "accessControlList": [
+    {
+      "grantee": [{"id": "*"}],
+      "permission": ["READ"],
+      "resource": ["another-bucket/*"]
+    }
+  ]
+}
+EOF
+}
+`, testAccBosBucketResourceAttrName)
+}
+
+func testAccBosBucketPolicyConfigInvalidPermission() string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket_policy" "default" {
+  bucket = "%[1]s"
+
+  policy = <<EOF
+{
+  "accessControlList": [
+    {
+      "grantee": [{"id": "*"}],
+      "permission": ["GetObjects"],
+      "resource": ["%[1]s/*"]
+    }
+  ]
+}
+EOF
+}
+`, testAccBosBucketResourceAttrName)
+}
@@ -0,0 +1,428 @@
+/*
+Provide a resource to manage a replication rule of a BOS Bucket, a bucket can have multiple replication rules managed by
+multiple resources. Do not set replication_configuration of baiducloud_bos_bucket for the same bucket at the same time.
+
+Example Usage
+
+```hcl
+resource "baiducloud_bos_bucket_replication" "default" {
+  bucket   = "my-bucket"
+  rule_id  = "replicate-images"
+  status   = "enabled"
+  resource = ["my-bucket/images/*"]
+
+  destination {
+    bucket        = "my-backup-bucket"
+    storage_class = "COLD"
+  }
+  replicate_history {
+    bucket        = "my-backup-bucket"
+    storage_class = "COLD"
+  }
+
+  replicate_deletes     = "disabled"
+  dest_region           = "gz"
+  wait_for_history_sync = true
+}
+```
+
+Import
+
+BOS Bucket Replication can be imported by bucket and rule id, e.g.
+
+```hcl
+$ terraform import baiducloud_bos_bucket_replication.default my-bucket,replicate-images
+```
+*/
+package baiducloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/services/bos"
+	"github.com/baidubce/bce-sdk-go/services/bos/api"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudBosBucketReplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudBosBucketReplicationCreate,
+		Read:   resourceBaiduCloudBosBucketReplicationRead,
+		Update: resourceBaiduCloudBosBucketReplicationUpdate,
+		Delete: resourceBaiduCloudBosBucketReplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Description: "Name of the source bucket.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"rule_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the replication rule, which is unique in the bucket.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Status of the replication rule. Valid values are enabled and disabled. Default to enabled.",
+				Optional:    true,
+				Default:     api.STATUS_ENABLED,
+				ValidateFunc: validation.StringInSlice([]string{
+					api.STATUS_ENABLED,
+					api.STATUS_DISABLED,
+				}, false),
+			},
+			"resource": {
+				Type:        schema.TypeSet,
+				Description: "Resource of the replication rule. The configuration format of the resource is {$bucket_name/<effective object prefix>}, which must start with \"$bucket_name\"+\"/\"",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: resourceHash,
+			},
+			"destination": {
+				Type:        schema.TypeList,
+				Description: "Destination of the replication rule.",
+				Required:    true,
+				MaxItems:    1,
+				MinItems:    1,
+				Elem:        resourceBaiduCloudBosBucketReplicationDescriptor(),
+			},
+			"replicate_history": {
+				Type:        schema.TypeList,
+				Description: "Configuration of the replicate history. The bucket name in replicate history needs to be the same as the bucket name in the destination above.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        resourceBaiduCloudBosBucketReplicationDescriptor(),
+			},
+			"replicate_deletes": {
+				Type:        schema.TypeString,
+				Description: "Whether to enable the delete synchronization, which can be enabled, disabled. Default to disabled.",
+				Optional:    true,
+				Default:     api.STATUS_DISABLED,
+				ValidateFunc: validation.StringInSlice([]string{
+					api.STATUS_DISABLED,
+					api.STATUS_ENABLED,
+				}, false),
+			},
+			"dest_region": {
+				Type:        schema.TypeString,
+				Description: "Region of the destination bucket, such as bj, gz, su and fwh.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"wait_for_history_sync": {
+				Type:        schema.TypeBool,
+				Description: "Whether to wait until the history objects are replicated when creating or updating the rule, only takes effect when replicate_history is set and the rule is enabled. Default to false.",
+				Optional:    true,
+				Default:     false,
+			},
+
+			// compute attributes
+			"create_time": {
+				Type:        schema.TypeInt,
+				Description: "Create time of the replication rule.",
+				Computed:    true,
+			},
+			"progress_status": {
+				Type:        schema.TypeString,
+				Description: "Replication status of the rule.",
+				Computed:    true,
+			},
+			"history_replication_percent": {
+				Type:        schema.TypeFloat,
+				Description: "Percent of the history objects replicated.",
+				Computed:    true,
+			},
+			"latest_replication_time": {
+				Type:        schema.TypeString,
+				Description: "Latest replication time of the rule.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudBosBucketReplicationDescriptor() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Description: "Destination bucket name of the replication rule.",
+				Required:    true,
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Description:  "Destination storage class of the replication rule, the parameter does not need to be configured if it is consistent with the storage class of the source bucket, if you need to specify the storage class separately, it can be COLD, STANDARD, STANDARD_IA.",
+				Optional:     true,
+				ValidateFunc: validateBOSBucketRCStorageClass(),
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudBosBucketReplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	bosService := &BosService{client}
+
+	bucket := d.Get("bucket").(string)
+	ruleId := d.Get("rule_id").(string)
+	action := "Create bucket " + bucket + " replication " + ruleId
+
+	// putting a rule with an existing id overwrites it, do not take over the rule managed elsewhere
+	rules, err := bosService.ListBucketReplication(bucket)
+	if err != nil && !IsExceptedErrors(err, ReplicationConfigurationNotFound) {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_replication", action, BCESDKGoERROR)
+	}
+	for _, rule := range rules {
+		if rule.Id == ruleId {
+			return WrapError(fmt.Errorf("replication rule %s of bucket %s already exists, import it instead", ruleId, bucket))
+		}
+	}
+
+	if err := putBaiduCloudBosBucketReplication(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_replication", action, BCESDKGoERROR)
+	}
+	d.SetId(strings.Join([]string{bucket, ruleId}, COLON_SEPARATED))
+
+	if err := waitBaiduCloudBosBucketReplicationHistorySync(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_replication", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudBosBucketReplicationRead(d, meta)
+}
+
+func resourceBaiduCloudBosBucketReplicationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	bosService := &BosService{client}
+
+	bucket, ruleId, err := parseBosBucketReplicationId(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	action := "Query bucket " + bucket + " replication " + ruleId
+
+	raw, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+		return bosClient.GetBucketReplication(bucket, ruleId)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		if IsExceptedErrors(err, BucketReplicationNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_replication", action, BCESDKGoERROR)
+	}
+	result, _ := raw.(*api.GetBucketReplicationResult)
+
+	rc := flattenBaiduCloudBucketReplicationConfiguration(result)
+	if len(rc) == 0 {
+		d.SetId("")
+		return nil
+	}
+	d.Set("bucket", bucket)
+	d.Set("rule_id", ruleId)
+	for _, k := range []string{"status", "resource", "destination", "replicate_history", "replicate_deletes"} {
+		if err := d.Set(k, rc[0][k]); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_replication", action, BCESDKGoERROR)
+		}
+	}
+	d.Set("dest_region", result.DestRegion)
+	d.Set("create_time", result.CreateTime)
+
+	progress, err := bosService.GetBucketReplicationProgress(bucket, ruleId)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_replication", action, BCESDKGoERROR)
+	}
+	d.Set("progress_status", progress.Status)
+	d.Set("history_replication_percent", progress.HistoryReplicationPercent)
+	d.Set("latest_replication_time", progress.LatestReplicationTime)
+
+	return nil
+}
+
+func resourceBaiduCloudBosBucketReplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	bucket := d.Get("bucket").(string)
+	ruleId := d.Get("rule_id").(string)
+	action := "Update bucket " + bucket + " replication " + ruleId
+
+	if d.HasChange("status") || d.HasChange("resource") || d.HasChange("destination") ||
+		d.HasChange("replicate_history") || d.HasChange("replicate_deletes") || d.HasChange("dest_region") {
+		// an enabled rule can not be modified in place, delete it before putting the new one,
+		// and keep the old one to put it back if the new one fails
+		var oldRule *api.GetBucketReplicationResult
+		if o, _ := d.GetChange("status"); o.(string) == api.STATUS_ENABLED {
+			raw, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+				return bosClient.GetBucketReplication(bucket, ruleId)
+			})
+			addDebug(action, raw)
+			if err != nil && !IsExceptedErrors(err, BucketReplicationNotFound) {
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_replication", action, BCESDKGoERROR)
+			}
+			oldRule, _ = raw.(*api.GetBucketReplicationResult)
+
+			_, err = client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+				return nil, bosClient.DeleteBucketReplication(bucket, ruleId)
+			})
+			addDebug(action, ruleId)
+			if err != nil && !IsExceptedErrors(err, BucketReplicationNotFound) {
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_replication", action, BCESDKGoERROR)
+			}
+		}
+
+		if err := putBaiduCloudBosBucketReplication(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if oldRule != nil {
+				args := api.PutBucketReplicationArgs(*oldRule)
+				args.CreateTime = 0
+				_, errRestore := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+					return nil, bosClient.PutBucketReplicationFromStruct(bucket, &args, ruleId)
+				})
+				addDebug(action, args)
+				if errRestore != nil {
+					err = fmt.Errorf("%s, and the old rule %s deleted before the update failed to be put back, "+
+						"the rule is removed from bucket %s: %s", err, ruleId, bucket, errRestore)
+				}
+			}
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_replication", action, BCESDKGoERROR)
+		}
+	}
+
+	if d.HasChange("status") || d.HasChange("replicate_history") || d.HasChange("wait_for_history_sync") {
+		if err := waitBaiduCloudBosBucketReplicationHistorySync(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_replication", action, BCESDKGoERROR)
+		}
+	}
+
+	return resourceBaiduCloudBosBucketReplicationRead(d, meta)
+}
+
+func resourceBaiduCloudBosBucketReplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	bucket := d.Get("bucket").(string)
+	ruleId := d.Get("rule_id").(string)
+	action := "Delete bucket " + bucket + " replication " + ruleId
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+			return nil, bosClient.DeleteBucketReplication(bucket, ruleId)
+		})
+		addDebug(action, ruleId)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if IsExceptedErrors(err, BucketReplicationNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_replication", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func putBaiduCloudBosBucketReplication(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	bucket := d.Get("bucket").(string)
+	ruleId := d.Get("rule_id").(string)
+	action := "Put bucket " + bucket + " replication " + ruleId
+
+	args := &api.PutBucketReplicationArgs{
+		Id:               ruleId,
+		Status:           d.Get("status").(string),
+		Resource:         expandStringSet(d.Get("resource").(*schema.Set)),
+		ReplicateDeletes: d.Get("replicate_deletes").(string),
+		DestRegion:       d.Get("dest_region").(string),
+		Destination:      buildBaiduCloudBosBucketReplicationDescriptor(d.Get("destination").([]interface{})),
+		ReplicateHistory: buildBaiduCloudBosBucketReplicationDescriptor(d.Get("replicate_history").([]interface{})),
+	}
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		_, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+			return nil, bosClient.PutBucketReplicationFromStruct(bucket, args, ruleId)
+		})
+		addDebug(action, args)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func waitBaiduCloudBosBucketReplicationHistorySync(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	if !d.Get("wait_for_history_sync").(bool) || d.Get("status").(string) != api.STATUS_ENABLED ||
+		len(d.Get("replicate_history").([]interface{})) == 0 {
+		return nil
+	}
+
+	client := meta.(*connectivity.BaiduClient)
+	bosService := &BosService{client}
+
+	bucket := d.Get("bucket").(string)
+	ruleId := d.Get("rule_id").(string)
+
+	stateConf := buildStateConf(
+		[]string{BOS_REPLICATION_HISTORY_SYNCING},
+		[]string{BOS_REPLICATION_HISTORY_FINISHED},
+		timeout,
+		bosService.BucketReplicationHistoryStateRefreshFunc(bucket, ruleId))
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func buildBaiduCloudBosBucketReplicationDescriptor(raw []interface{}) *api.BucketReplicationDescriptor {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	descriptor := raw[0].(map[string]interface{})
+
+	return &api.BucketReplicationDescriptor{
+		Bucket:       descriptor["bucket"].(string),
+		StorageClass: descriptor["storage_class"].(string),
+	}
+}
+
+func parseBosBucketReplicationId(id string) (string, string, error) {
+	items := strings.SplitN(id, COLON_SEPARATED, 2)
+	if len(items) != 2 || items[0] == "" || items[1] == "" {
+		return "", "", fmt.Errorf("invalid BOS bucket replication id %s, should be bucket,rule_id", id)
+	}
+
+	return items[0], items[1], nil
+}
@@ -0,0 +1,115 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	testAccBosBucketReplicationResourceType = "baiducloud_bos_bucket_replication"
+	testAccBosBucketReplicationResourceName = testAccBosBucketReplicationResourceType + "." + BaiduCloudTestResourceName
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBosBucketReplication(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBosBucketReplicationDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBosBucketReplicationConfig("enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosBucketReplicationResourceName),
+					resource.TestCheckResourceAttr(testAccBosBucketReplicationResourceName, "bucket", testAccBosBucketResourceAttrName),
+					resource.TestCheckResourceAttr(testAccBosBucketReplicationResourceName, "rule_id", "test-rule"),
+					resource.TestCheckResourceAttr(testAccBosBucketReplicationResourceName, "status", "enabled"),
+					resource.TestCheckResourceAttr(testAccBosBucketReplicationResourceName, "resource.#", "1"),
+					resource.TestCheckResourceAttr(testAccBosBucketReplicationResourceName, "destination.0.bucket", testAccBosBucketResourceAttrName+"-dst"),
+					resource.TestCheckResourceAttr(testAccBosBucketReplicationResourceName, "replicate_history.0.bucket", testAccBosBucketResourceAttrName+"-dst"),
+					resource.TestCheckResourceAttr(testAccBosBucketReplicationResourceName, "history_replication_percent", "100"),
+					resource.TestCheckResourceAttrSet(testAccBosBucketReplicationResourceName, "progress_status"),
+				),
+			},
+			{
+				ResourceName:            testAccBosBucketReplicationResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_history_sync"},
+			},
+			{
+				Config: testAccBosBucketReplicationConfig("disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosBucketReplicationResourceName),
+					resource.TestCheckResourceAttr(testAccBosBucketReplicationResourceName, "status", "disabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBosBucketReplicationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.BaiduClient)
+	bosService := &BosService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccBosBucketReplicationResourceType {
+			continue
+		}
+
+		bucket, ruleId, err := parseBosBucketReplicationId(rs.Primary.ID)
+		if err != nil {
+			return WrapError(err)
+		}
+		rules, err := bosService.ListBucketReplication(bucket)
+		if err != nil {
+			if IsExceptedErrors(err, BucketReplicationNotFound) {
+				continue
+			}
+			return WrapError(err)
+		}
+		for _, rule := range rules {
+			if rule.Id == ruleId {
+				return WrapError(Error("BOS bucket replication still exist"))
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccBosBucketReplicationConfig(status string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%[1]s"
+}
+
+resource "baiducloud_bos_bucket" "destination" {
+  bucket = "%[1]s-dst"
+}
+
+resource "baiducloud_bos_bucket_replication" "default" {
+  bucket   = baiducloud_bos_bucket.default.bucket
+  rule_id  = "test-rule"
+  status   = "%[2]s"
+  resource = ["${baiducloud_bos_bucket.default.bucket}/*"]
+
+  destination {
+    bucket = baiducloud_bos_bucket.destination.bucket
+  }
+  replicate_history {
+    bucket = baiducloud_bos_bucket.destination.bucket
+  }
+
+  wait_for_history_sync = true
+}
+`, testAccBosBucketResourceAttrName, status)
+}
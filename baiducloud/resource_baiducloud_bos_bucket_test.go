@@ -152,6 +152,8 @@ func TestAccBaiduCloudBosBucket(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccBosBucketResourceName, "cors_rule.0.allowed_methods.0", "POST"),
 					resource.TestCheckResourceAttr(testAccBosBucketResourceName, "cors_rule.0.max_age_seconds", "1800"),
 					resource.TestCheckResourceAttr(testAccBosBucketResourceName, "copyright_protection.0.resource.#", "1"),
+					resource.TestCheckResourceAttr(testAccBosBucketResourceName, "trash.#", "1"),
+					resource.TestCheckResourceAttr(testAccBosBucketResourceName, "trash.0.trash_dir", ".trash"),
 					resource.TestCheckResourceAttrSet(testAccBosBucketResourceName, "location"),
 					resource.TestCheckResourceAttrSet(testAccBosBucketResourceName, "creation_date"),
 					resource.TestCheckResourceAttrSet(testAccBosBucketResourceName, "owner_id"),
@@ -170,6 +172,7 @@ func TestAccBaiduCloudBosBucket(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccBosBucketResourceName, "website.#", "0"),
 					resource.TestCheckResourceAttr(testAccBosBucketResourceName, "cors_rule.#", "0"),
 					resource.TestCheckResourceAttr(testAccBosBucketResourceName, "copyright_protection.0.resource.#", "0"),
+					resource.TestCheckResourceAttr(testAccBosBucketResourceName, "trash.#", "0"),
 					resource.TestCheckResourceAttrSet(testAccBosBucketResourceName, "location"),
 					resource.TestCheckResourceAttrSet(testAccBosBucketResourceName, "creation_date"),
 					resource.TestCheckResourceAttrSet(testAccBosBucketResourceName, "owner_id"),
@@ -344,6 +347,8 @@ resource "baiducloud_bos_bucket" "default" {
   copyright_protection {
     resource = ["%s"]
   }
+
+  trash {}
 }
 `, BaiduCloudTestBucketResourceAttrNamePrefix+"bucket-peer", testAccBosBucketResourceAttrName,
 		testAccBosBucketResourceAttrName+"/*", testAccBosBucketResourceAttrName,
@@ -0,0 +1,260 @@
+/*
+Provide a resource to manage the ACL grants of a BOS object, which grants the permissions of the object to the given
+users. Do not set acl of baiducloud_bos_bucket_object for the same object at the same time.
+
+Example Usage
+
+```hcl
+resource "baiducloud_bos_object_acl" "default" {
+  bucket = "my-bucket"
+  key    = "releases/app.tar.gz"
+
+  grant {
+    grantee    = ["*"]
+    permission = ["READ"]
+  }
+  grant {
+    grantee    = ["c1ab0e1c8e8a4b9f9c3f3d3e2a1b0c9d"]
+    permission = ["FULL_CONTROL"]
+  }
+}
+```
+
+Import
+
+BOS Object ACL can be imported by bucket and key, e.g.
+
+```hcl
+$ terraform import baiducloud_bos_object_acl.default my-bucket,releases/app.tar.gz
+```
+*/
+package baiducloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/services/bos"
+	"github.com/baidubce/bce-sdk-go/services/bos/api"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudBosObjectAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudBosObjectAclCreate,
+		Read:   resourceBaiduCloudBosObjectAclRead,
+		Update: resourceBaiduCloudBosObjectAclUpdate,
+		Delete: resourceBaiduCloudBosObjectAclDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Description: "Name of the bucket.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Description: "Key of the object.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"grant": {
+				Type:        schema.TypeList,
+				Description: "Grants of the object.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grantee": {
+							Type:        schema.TypeSet,
+							Description: "IDs of the users granted, * means all the users.",
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"permission": {
+							Type:        schema.TypeSet,
+							Description: "Permissions granted, valid values are READ and FULL_CONTROL.",
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"READ", "FULL_CONTROL"}, false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudBosObjectAclCreate(d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	action := "Create bucket " + bucket + " object " + key + " acl"
+
+	if err := putBaiduCloudBosObjectAcl(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_object_acl", action, BCESDKGoERROR)
+	}
+	d.SetId(strings.Join([]string{bucket, key}, COLON_SEPARATED))
+
+	return resourceBaiduCloudBosObjectAclRead(d, meta)
+}
+
+func resourceBaiduCloudBosObjectAclRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	bucket, key, err := parseBosObjectId(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	action := "Query bucket " + bucket + " object " + key + " acl"
+
+	raw, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+		return bosClient.GetObjectAcl(bucket, key)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		if IsExceptedErrors(err, BosObjectNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_object_acl", action, BCESDKGoERROR)
+	}
+
+	grants := make([]map[string]interface{}, 0)
+	if result, ok := raw.(*api.GetObjectAclResult); ok && result != nil {
+		for _, grant := range result.AccessControlList {
+			grantee := make([]interface{}, 0, len(grant.Grantee))
+			for _, g := range grant.Grantee {
+				grantee = append(grantee, g.Id)
+			}
+			grants = append(grants, map[string]interface{}{
+				"grantee":    grantee,
+				"permission": flattenStringListToInterface(grant.Permission),
+			})
+		}
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("key", key)
+	if err := d.Set("grant", grants); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_object_acl", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func resourceBaiduCloudBosObjectAclUpdate(d *schema.ResourceData, meta interface{}) error {
+	action := "Update bucket " + d.Get("bucket").(string) + " object " + d.Get("key").(string) + " acl"
+
+	if d.HasChange("grant") {
+		if err := putBaiduCloudBosObjectAcl(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_object_acl", action, BCESDKGoERROR)
+		}
+	}
+
+	return resourceBaiduCloudBosObjectAclRead(d, meta)
+}
+
+func resourceBaiduCloudBosObjectAclDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	action := "Delete bucket " + bucket + " object " + key + " acl"
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+			return nil, bosClient.DeleteObjectAcl(bucket, key)
+		})
+		addDebug(action, key)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if IsExceptedErrors(err, BosObjectNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_object_acl", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func putBaiduCloudBosObjectAcl(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	action := "Put bucket " + bucket + " object " + key + " acl"
+
+	args := &api.PutObjectAclArgs{
+		AccessControlList: make([]api.GrantType, 0),
+	}
+	for _, raw := range d.Get("grant").([]interface{}) {
+		grant := raw.(map[string]interface{})
+
+		grantee := make([]api.GranteeType, 0)
+		for _, id := range expandStringSet(grant["grantee"].(*schema.Set)) {
+			grantee = append(grantee, api.GranteeType{Id: id})
+		}
+		args.AccessControlList = append(args.AccessControlList, api.GrantType{
+			Grantee:    grantee,
+			Permission: expandStringSet(grant["permission"].(*schema.Set)),
+		})
+	}
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		_, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+			return nil, bosClient.PutObjectAclFromStruct(bucket, key, args)
+		})
+		addDebug(action, args)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+// parseBosObjectId splits the id joined by the bucket and the key, the key may contain the separator
+func parseBosObjectId(id string) (string, string, error) {
+	items := strings.SplitN(id, COLON_SEPARATED, 2)
+	if len(items) != 2 || items[0] == "" || items[1] == "" {
+		return "", "", fmt.Errorf("invalid BOS object id %s, should be bucket,key", id)
+	}
+
+	return items[0], items[1], nil
+}
@@ -0,0 +1,72 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccBosObjectAclResourceType = "baiducloud_bos_object_acl"
+	testAccBosObjectAclResourceName = testAccBosObjectAclResourceType + "." + BaiduCloudTestResourceName
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBosObjectAcl(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBosBucketObjectDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBosObjectAclConfig("READ"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosObjectAclResourceName),
+					resource.TestCheckResourceAttr(testAccBosObjectAclResourceName, "grant.#", "1"),
+					resource.TestCheckResourceAttr(testAccBosObjectAclResourceName, "grant.0.grantee.#", "1"),
+					resource.TestCheckResourceAttr(testAccBosObjectAclResourceName, "grant.0.permission.#", "1"),
+				),
+			},
+			{
+				ResourceName:      testAccBosObjectAclResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBosObjectAclConfig("FULL_CONTROL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosObjectAclResourceName),
+					resource.TestCheckResourceAttr(testAccBosObjectAclResourceName, "grant.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBosObjectAclConfig(permission string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%s"
+}
+
+resource "baiducloud_bos_bucket_object" "default" {
+  bucket  = baiducloud_bos_bucket.default.bucket
+  key     = "%s"
+  content = "hello world"
+}
+
+resource "baiducloud_bos_object_acl" "default" {
+  bucket = baiducloud_bos_bucket_object.default.bucket
+  key    = baiducloud_bos_bucket_object.default.key
+
+  grant {
+    grantee    = ["*"]
+    permission = ["%s"]
+  }
+}
+`, testAccBosBucketResourceAttrName, testAccBosBucketObjectResourceAttrName, permission)
+}
@@ -0,0 +1,291 @@
+/*
+Provide a resource to copy a BOS object server-side, the source object can be in another bucket or region. The objects not
+smaller than multipart_threshold_in_mb or in another region are copied in multiple parts in parallel.
+
+Example Usage
+
+```hcl
+resource "baiducloud_bos_object_copy" "default" {
+  bucket        = "release-bucket"
+  key           = "releases/v1.2.0/app.tar.gz"
+  source_bucket = "staging-bucket"
+  source_key    = "builds/1234/app.tar.gz"
+  source_region = "gz"
+  source_etag   = "3707cecfcaece142b627f0a10faf7573"
+}
+```
+*/
+package baiducloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/services/bos"
+	"github.com/baidubce/bce-sdk-go/services/bos/api"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudBosObjectCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudBosObjectCopyCreate,
+		Read:   resourceBaiduCloudBosObjectCopyRead,
+		Update: resourceBaiduCloudBosObjectCopyUpdate,
+		Delete: resourceBaiduCloudBosObjectCopyDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Description: "Name of the bucket to copy the object to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Description: "Key of the copied object.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"source_bucket": {
+				Type:        schema.TypeString,
+				Description: "Name of the bucket of the source object.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"source_key": {
+				Type:        schema.TypeString,
+				Description: "Key of the source object.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"source_region": {
+				Type:        schema.TypeString,
+				Description: "Region of the source bucket, such as bj, gz, su and fwh. Default to the region of the provider.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"source_etag": {
+				Type:        schema.TypeString,
+				Description: "ETag of the source object, the copy fails if the source object does not match it. Changing it copies the object again. For the copy in parts, the source object is checked again after the copy, and the copied object is deleted if the source object has changed.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Description:  "Storage class of the copied object, which can be COLD, STANDARD_IA, STANDARD or ARCHIVE. Default to the storage class of the source object.",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateBOSBucketStorageClass(),
+			},
+			"multipart_threshold_in_mb": {
+				Type:         schema.TypeInt,
+				Description:  "Size threshold(MB) of the source object, the object not smaller than it will be copied in multiple parts in parallel, support between 1 and 5120. Default to 100.",
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, 5120),
+			},
+
+			// compute attributes
+			"etag": {
+				Type:        schema.TypeString,
+				Description: "ETag of the copied object.",
+				Computed:    true,
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Description: "Content type of the copied object.",
+				Computed:    true,
+			},
+			"content_length": {
+				Type:        schema.TypeInt,
+				Description: "Content length of the copied object.",
+				Computed:    true,
+			},
+			"last_modified": {
+				Type:        schema.TypeString,
+				Description: "Last modified time of the copied object.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudBosObjectCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	bosService := &BosService{client}
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	srcBucket := d.Get("source_bucket").(string)
+	srcKey := d.Get("source_key").(string)
+	action := "Copy bucket " + srcBucket + " object " + srcKey + " to bucket " + bucket + " object " + key
+
+	// the copy may last long, use the clients without holding the sdk mutex
+	bosClient, err := bosService.concurrentClient()
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_object_copy", action, BCESDKGoERROR)
+	}
+	srcClient := bosClient
+	crossRegion := false
+	if v, ok := d.GetOk("source_region"); ok && connectivity.Region(v.(string)) != client.Region {
+		crossRegion = true
+		srcClient, err = client.NewBosRegionClient(connectivity.Region(v.(string)))
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_object_copy", action, BCESDKGoERROR)
+		}
+	}
+
+	srcMeta, err := srcClient.GetObjectMeta(srcBucket, srcKey)
+	addDebug(action, srcMeta)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_object_copy", action, BCESDKGoERROR)
+	}
+	srcEtag := strings.Trim(srcMeta.ETag, "\"")
+	if v, ok := d.GetOk("source_etag"); ok && !strings.EqualFold(v.(string), srcEtag) {
+		return WrapError(fmt.Errorf("ETag %s of the source object does not match source_etag %s", srcEtag, v.(string)))
+	}
+
+	storageClass := d.Get("storage_class").(string)
+	threshold := int64(d.Get("multipart_threshold_in_mb").(int)) << 20
+	if crossRegion && srcMeta.ContentLength == 0 {
+		// an empty object can not be copied in parts, and the destination region can not copy from another region,
+		// so put an empty object with the same meta instead
+		if storageClass == "" {
+			storageClass = srcMeta.StorageClass
+		}
+		args := &api.PutObjectArgs{
+			CacheControl:       srcMeta.CacheControl,
+			ContentDisposition: srcMeta.ContentDisposition,
+			ContentType:        srcMeta.ContentType,
+			Expires:            srcMeta.Expires,
+			UserMeta:           srcMeta.UserMeta,
+			StorageClass:       storageClass,
+		}
+		_, err = bosClient.PutObjectFromBytes(bucket, key, []byte{}, args)
+		addDebug(action, args)
+	} else if srcMeta.ContentLength >= threshold || crossRegion {
+		args := &api.MultiCopyObjectArgs{
+			StorageClass: storageClass,
+		}
+		_, err = bosClient.ParallelCopy(srcBucket, srcKey, bucket, key, args, srcClient)
+		addDebug(action, args)
+		if err == nil {
+			err = checkBosParallelCopiedObject(bosClient, srcClient, srcBucket, srcKey, bucket, key, srcMeta)
+		}
+	} else {
+		args := &api.CopyObjectArgs{
+			ObjectMeta: api.ObjectMeta{
+				StorageClass: storageClass,
+			},
+			IfMatch: srcMeta.ETag,
+		}
+		_, err = bosClient.CopyObject(bucket, key, srcBucket, srcKey, args)
+		addDebug(action, args)
+	}
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_object_copy", action, BCESDKGoERROR)
+	}
+
+	d.SetId(strings.Join([]string{bucket, key}, COLON_SEPARATED))
+	d.Set("source_etag", srcEtag)
+
+	return resourceBaiduCloudBosObjectCopyRead(d, meta)
+}
+
+// checkBosParallelCopiedObject makes sure that the object copied in parts is the source object checked against
+// source_etag. The parts are copied on condition of the source etag got by ParallelCopy itself, which may differ
+// from srcMeta if the source object is overwritten in between, and the etag of a multipart object is not the one of
+// its content, so the source etag and the size are compared after the copy, and the copied object is deleted if
+// they do not match.
+func checkBosParallelCopiedObject(bosClient, srcClient *bos.Client, srcBucket, srcKey, bucket, key string,
+	srcMeta *api.GetObjectMetaResult) error {
+	var mismatch error
+	afterMeta, err := srcClient.GetObjectMeta(srcBucket, srcKey)
+	if err != nil {
+		mismatch = fmt.Errorf("get the source object meta after copy error: %s", err)
+	} else if afterMeta.ETag != srcMeta.ETag {
+		mismatch = fmt.Errorf("the source object is changed during the copy, ETag %s is changed to %s",
+			strings.Trim(srcMeta.ETag, "\""), strings.Trim(afterMeta.ETag, "\""))
+	} else if copiedMeta, err := bosClient.GetObjectMeta(bucket, key); err != nil {
+		mismatch = fmt.Errorf("get the copied object meta error: %s", err)
+	} else if copiedMeta.ContentLength != srcMeta.ContentLength {
+		mismatch = fmt.Errorf("content length %d of the copied object does not match %d of the source object",
+			copiedMeta.ContentLength, srcMeta.ContentLength)
+	}
+	if mismatch == nil {
+		return nil
+	}
+
+	if err := bosClient.DeleteObject(bucket, key); err != nil && !IsExceptedErrors(err, BosObjectNotFound) {
+		return fmt.Errorf("%s, and deleting the copied object error: %s", mismatch, err)
+	}
+	return mismatch
+}
+
+func resourceBaiduCloudBosObjectCopyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	action := "Query bucket " + bucket + " object " + key
+
+	raw, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+		return bosClient.GetObjectMeta(bucket, key)
+	})
+	if err != nil {
+		if IsExceptedErrors(err, BosObjectNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_object_copy", action, BCESDKGoERROR)
+	}
+	addDebug(action, raw)
+
+	result, _ := raw.(*api.GetObjectMetaResult)
+	d.Set("etag", strings.Trim(result.ETag, "\""))
+	d.Set("content_type", result.ContentType)
+	d.Set("content_length", result.ContentLength)
+	d.Set("last_modified", result.LastModified)
+	if result.StorageClass != "" {
+		d.Set("storage_class", result.StorageClass)
+	}
+
+	return nil
+}
+
+func resourceBaiduCloudBosObjectCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	// only multipart_threshold_in_mb can be updated, which takes effect on the next copy
+	return resourceBaiduCloudBosObjectCopyRead(d, meta)
+}
+
+func resourceBaiduCloudBosObjectCopyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	action := "Delete bucket " + bucket + " object " + key
+
+	_, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+		return nil, bosClient.DeleteObject(bucket, key)
+	})
+	addDebug(action, key)
+	if err != nil {
+		if IsExceptedErrors(err, BosObjectNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_object_copy", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccBosObjectCopyResourceType = "baiducloud_bos_object_copy"
+	testAccBosObjectCopyResourceName = testAccBosObjectCopyResourceType + "." + BaiduCloudTestResourceName
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBosObjectCopy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBosBucketObjectDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBosObjectCopyConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosObjectCopyResourceName),
+					resource.TestCheckResourceAttr(testAccBosObjectCopyResourceName, "key", testAccBosBucketObjectResourceAttrName+"-copy"),
+					resource.TestCheckResourceAttr(testAccBosObjectCopyResourceName, "content_length", "11"),
+					resource.TestCheckResourceAttrPair(testAccBosObjectCopyResourceName, "source_etag", testAccBosBucketObjectResourceName, "etag"),
+					resource.TestCheckResourceAttrPair(testAccBosObjectCopyResourceName, "etag", testAccBosBucketObjectResourceName, "etag"),
+					resource.TestCheckResourceAttr(testAccBosObjectCopyResourceName, "storage_class", "STANDARD_IA"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBosObjectCopyConfig() string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%[1]s"
+}
+
+resource "baiducloud_bos_bucket_object" "default" {
+  bucket  = baiducloud_bos_bucket.default.bucket
+  key     = "%[2]s"
+  content = "hello world"
+}
+
+resource "baiducloud_bos_object_copy" "default" {
+  bucket        = baiducloud_bos_bucket.default.bucket
+  key           = "%[2]s-copy"
+  source_bucket = baiducloud_bos_bucket_object.default.bucket
+  source_key    = baiducloud_bos_bucket_object.default.key
+  storage_class = "STANDARD_IA"
+}
+`, testAccBosBucketResourceAttrName, testAccBosBucketObjectResourceAttrName)
+}
@@ -0,0 +1,220 @@
+/*
+Provide a resource to create a symlink pointing to an object in the same BOS bucket.
+
+Example Usage
+
+```hcl
+resource "baiducloud_bos_object_symlink" "default" {
+  bucket = "my-bucket"
+  key    = "releases/latest.tar.gz"
+  target = "releases/v1.2.0.tar.gz"
+}
+```
+
+Import
+
+BOS Object Symlink can be imported by bucket and key, e.g.
+
+```hcl
+$ terraform import baiducloud_bos_object_symlink.default my-bucket,releases/latest.tar.gz
+```
+*/
+package baiducloud
+
+import (
+	"strings"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/services/bos"
+	"github.com/baidubce/bce-sdk-go/services/bos/api"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudBosObjectSymlink() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudBosObjectSymlinkCreate,
+		Read:   resourceBaiduCloudBosObjectSymlinkRead,
+		Update: resourceBaiduCloudBosObjectSymlinkUpdate,
+		Delete: resourceBaiduCloudBosObjectSymlinkDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Description: "Name of the bucket.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Description: "Key of the symlink.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"target": {
+				Type:        schema.TypeString,
+				Description: "Key of the object the symlink points to.",
+				Required:    true,
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Description:  "Storage class of the symlink, which can be COLD, STANDARD_IA or STANDARD.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{api.STORAGE_CLASS_COLD, api.STORAGE_CLASS_STANDARD_IA, api.STORAGE_CLASS_STANDARD}, false),
+			},
+			"user_meta": {
+				Type:        schema.TypeMap,
+				Description: "User metadata of the symlink.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"forbid_overwrite": {
+				Type:        schema.TypeBool,
+				Description: "Whether to fail the creation if an object with the same key exists. Default to false.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudBosObjectSymlinkCreate(d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	action := "Create bucket " + bucket + " symlink " + key
+
+	if err := putBaiduCloudBosObjectSymlink(d, meta, d.Get("forbid_overwrite").(bool), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_object_symlink", action, BCESDKGoERROR)
+	}
+	d.SetId(strings.Join([]string{bucket, key}, COLON_SEPARATED))
+
+	return resourceBaiduCloudBosObjectSymlinkRead(d, meta)
+}
+
+func resourceBaiduCloudBosObjectSymlinkRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	bucket, key, err := parseBosObjectId(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	action := "Query bucket " + bucket + " symlink " + key
+
+	target, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+		return bosClient.GetSymlink(bucket, key)
+	})
+	addDebug(action, target)
+	if err != nil {
+		if IsExceptedErrors(err, BosObjectNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_object_symlink", action, BCESDKGoERROR)
+	}
+
+	raw, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+		return bosClient.GetObjectMeta(bucket, key)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_object_symlink", action, BCESDKGoERROR)
+	}
+	result, _ := raw.(*api.GetObjectMetaResult)
+
+	d.Set("bucket", bucket)
+	d.Set("key", key)
+	d.Set("target", target.(string))
+	d.Set("storage_class", result.StorageClass)
+	d.Set("user_meta", result.UserMeta)
+
+	return nil
+}
+
+func resourceBaiduCloudBosObjectSymlinkUpdate(d *schema.ResourceData, meta interface{}) error {
+	action := "Update bucket " + d.Get("bucket").(string) + " symlink " + d.Get("key").(string)
+
+	if d.HasChange("target") || d.HasChange("storage_class") || d.HasChange("user_meta") {
+		// the symlink exists already, overwrite it in place
+		if err := putBaiduCloudBosObjectSymlink(d, meta, false, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_object_symlink", action, BCESDKGoERROR)
+		}
+	}
+
+	return resourceBaiduCloudBosObjectSymlinkRead(d, meta)
+}
+
+func resourceBaiduCloudBosObjectSymlinkDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	action := "Delete bucket " + bucket + " symlink " + key
+
+	_, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+		return nil, bosClient.DeleteObject(bucket, key)
+	})
+	addDebug(action, key)
+	if err != nil {
+		if IsExceptedErrors(err, BosObjectNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_object_symlink", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func putBaiduCloudBosObjectSymlink(d *schema.ResourceData, meta interface{}, forbidOverwrite bool, timeout time.Duration) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	target := d.Get("target").(string)
+	action := "Put bucket " + bucket + " symlink " + key
+
+	args := &api.PutSymlinkArgs{
+		StorageClass: d.Get("storage_class").(string),
+	}
+	if forbidOverwrite {
+		args.ForbidOverwrite = "true"
+	}
+	if v, ok := d.GetOk("user_meta"); ok {
+		userMeta := make(map[string]string)
+		for k, val := range v.(map[string]interface{}) {
+			userMeta[k] = val.(string)
+		}
+		args.UserMeta = userMeta
+	}
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		_, err := client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+			return nil, bosClient.PutSymlink(bucket, target, key, args)
+		})
+		addDebug(action, args)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,74 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccBosObjectSymlinkResourceType = "baiducloud_bos_object_symlink"
+	testAccBosObjectSymlinkResourceName = testAccBosObjectSymlinkResourceType + "." + BaiduCloudTestResourceName
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBosObjectSymlink(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBosBucketObjectDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBosObjectSymlinkConfig("v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosObjectSymlinkResourceName),
+					resource.TestCheckResourceAttr(testAccBosObjectSymlinkResourceName, "target", testAccBosBucketObjectResourceAttrName+"-v1"),
+					resource.TestCheckResourceAttrSet(testAccBosObjectSymlinkResourceName, "storage_class"),
+				),
+			},
+			{
+				ResourceName:            testAccBosObjectSymlinkResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"forbid_overwrite"},
+			},
+			{
+				Config: testAccBosObjectSymlinkConfig("v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBosObjectSymlinkResourceName),
+					resource.TestCheckResourceAttr(testAccBosObjectSymlinkResourceName, "target", testAccBosBucketObjectResourceAttrName+"-v2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBosObjectSymlinkConfig(version string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%[1]s"
+}
+
+resource "baiducloud_bos_bucket_object" "v1" {
+  bucket  = baiducloud_bos_bucket.default.bucket
+  key     = "%[2]s-v1"
+  content = "hello v1"
+}
+
+resource "baiducloud_bos_bucket_object" "v2" {
+  bucket  = baiducloud_bos_bucket.default.bucket
+  key     = "%[2]s-v2"
+  content = "hello v2"
+}
+
+resource "baiducloud_bos_object_symlink" "default" {
+  bucket = baiducloud_bos_bucket.default.bucket
+  key    = "%[2]s-latest"
+  target = baiducloud_bos_bucket_object.%[3]s.key
+}
+`, testAccBosBucketResourceAttrName, testAccBosBucketObjectResourceAttrName, version)
+}
@@ -1,5 +1,9 @@
 /*
-Provide a resource to Upload a cert.
+Provide a resource to Upload a cert. Changing the cert data rotates the cert in place and keeps the cert id,
+so the listeners referring to it are not changed.
+
+The cert data is checked at plan time: the private key must match the server cert, the chain must be ordered
+from the server cert to the root, and the server cert must not be expired.
 
 Example Usage
 
@@ -14,6 +18,13 @@ resource "baiducloud_cert" "cert" {
 package baiducloud
 
 import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/baidubce/bce-sdk-go/bce"
@@ -30,6 +41,9 @@ func resourceBaiduCloudCert() *schema.Resource {
 		Read:   resourceBaiduCloudCertRead,
 		Update: resourceBaiduCloudCertUpdate,
 		Delete: resourceBaiduCloudCertDelete,
+
+		CustomizeDiff: resourceBaiduCloudCertCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -47,19 +61,25 @@ func resourceBaiduCloudCert() *schema.Resource {
 				Required:    true,
 			},
 			"cert_server_data": {
-				Type:        schema.TypeString,
-				Description: "Server Cert data, base64 encode",
-				Required:    true,
+				Type:             schema.TypeString,
+				Description:      "Server Cert data, base64 encode",
+				Required:         true,
+				ValidateFunc:     validateCertPEM,
+				DiffSuppressFunc: pemDiffSuppressFunc,
 			},
 			"cert_private_data": {
-				Type:        schema.TypeString,
-				Description: "Cert private key data, base64 encode",
-				Required:    true,
+				Type:             schema.TypeString,
+				Description:      "Cert private key data, base64 encode",
+				Required:         true,
+				ValidateFunc:     validatePrivateKeyPEM,
+				DiffSuppressFunc: pemDiffSuppressFunc,
 			},
 			"cert_link_data": {
-				Type:        schema.TypeString,
-				Description: "Cert lint data, base64 encode",
-				Optional:    true,
+				Type:             schema.TypeString,
+				Description:      "Cert lint data, base64 encode",
+				Optional:         true,
+				ValidateFunc:     validateCertPEM,
+				DiffSuppressFunc: pemDiffSuppressFunc,
 			},
 			"cert_type": {
 				Type:        schema.TypeInt,
@@ -92,6 +112,29 @@ func resourceBaiduCloudCert() *schema.Resource {
 				Description: "Cert update time",
 				Computed:    true,
 			},
+			"cert_dns_names": {
+				Type:        schema.TypeList,
+				Description: "DNS names in the subject alternative names of the server cert, parsed from cert_server_data",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"cert_issuer": {
+				Type:        schema.TypeString,
+				Description: "Issuer of the server cert, parsed from cert_server_data",
+				Computed:    true,
+			},
+			"cert_fingerprint": {
+				Type:        schema.TypeString,
+				Description: "SHA-256 fingerprint of the server cert in hex, parsed from cert_server_data",
+				Computed:    true,
+			},
+			"cert_not_after": {
+				Type:        schema.TypeString,
+				Description: "Expiration time of the server cert, parsed from cert_server_data",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -149,6 +192,15 @@ func resourceBaiduCloudCertRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("cert_update_time", certMeta.CertUpdateTime)
 	d.Set("cert_type", certMeta.CertType)
 
+	// cert data can not be queried, so parse the attributes from the configured cert if there is one
+	if v, ok := d.GetOk("cert_server_data"); ok {
+		if certs, err := parseCertificatesPEM(v.(string)); err == nil {
+			for key, value := range flattenCertificateToMap(certs[0]) {
+				d.Set(key, value)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -226,6 +278,7 @@ func resourceBaiduCloudCertUpdate(d *schema.ResourceData, meta interface{}) erro
 		d.SetPartial("cert_type")
 	}
 
+	d.Partial(false)
 	return resourceBaiduCloudCertRead(d, meta)
 }
 
@@ -257,6 +310,34 @@ func resourceBaiduCloudCertDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func resourceBaiduCloudCertCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("cert_server_data") && !d.HasChange("cert_private_data") && !d.HasChange("cert_link_data") {
+		return nil
+	}
+
+	if !d.NewValueKnown("cert_server_data") || !d.NewValueKnown("cert_private_data") || !d.NewValueKnown("cert_link_data") {
+		for key := range flattenCertificateToMap(&x509.Certificate{}) {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	leaf, err := checkCertData(d.Get("cert_server_data").(string), d.Get("cert_private_data").(string), d.Get("cert_link_data").(string))
+	if err != nil {
+		return err
+	}
+
+	for key, value := range flattenCertificateToMap(leaf) {
+		if err := d.SetNew(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func buildBaiduCloudCreateCertArgs(d *schema.ResourceData) *cert.CreateCertArgs {
 	result := &cert.CreateCertArgs{}
 
@@ -282,3 +363,94 @@ func buildBaiduCloudCreateCertArgs(d *schema.ResourceData) *cert.CreateCertArgs
 
 	return result
 }
+
+// normalizePEM drops the line endings and blank characters which are not meaningful in PEM data
+func normalizePEM(data string) string {
+	lines := make([]string, 0)
+	for _, line := range strings.Split(strings.Replace(data, "\r\n", "\n", -1), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func parseCertificatesPEM(data string) ([]*x509.Certificate, error) {
+	certs := make([]*x509.Certificate, 0)
+
+	rest := []byte(normalizePEM(data))
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected PEM block type %s", block.Type)
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificate found")
+	}
+
+	return certs, nil
+}
+
+// checkCertData checks the chain is ordered, the private key matches the server cert and the server cert
+// is not expired, and returns the server cert
+func checkCertData(serverData, privateData, linkData string) (*x509.Certificate, error) {
+	chain, err := parseCertificatesPEM(serverData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cert_server_data: %s", err)
+	}
+
+	if linkData != "" {
+		link, err := parseCertificatesPEM(linkData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cert_link_data: %s", err)
+		}
+		chain = append(chain, link...)
+	}
+
+	for i := 0; i < len(chain)-1; i++ {
+		if err := chain[i].CheckSignatureFrom(chain[i+1]); err != nil {
+			return nil, fmt.Errorf("cert chain is not ordered, %q is not issued by %q: %s",
+				chain[i].Subject.String(), chain[i+1].Subject.String(), err)
+		}
+	}
+
+	if _, err := tls.X509KeyPair([]byte(normalizePEM(serverData)), []byte(normalizePEM(privateData))); err != nil {
+		return nil, fmt.Errorf("cert_private_data does not match cert_server_data: %s", err)
+	}
+
+	leaf := chain[0]
+	if time.Now().After(leaf.NotAfter) {
+		return nil, fmt.Errorf("cert %q has expired at %s", leaf.Subject.String(), leaf.NotAfter.Format(time.RFC3339))
+	}
+
+	return leaf, nil
+}
+
+func flattenCertificateToMap(cert *x509.Certificate) map[string]interface{} {
+	fingerprint := sha256.Sum256(cert.Raw)
+
+	dnsNames := make([]interface{}, 0, len(cert.DNSNames))
+	for _, name := range cert.DNSNames {
+		dnsNames = append(dnsNames, name)
+	}
+
+	return map[string]interface{}{
+		"cert_dns_names":   dnsNames,
+		"cert_issuer":      cert.Issuer.String(),
+		"cert_fingerprint": hex.EncodeToString(fingerprint[:]),
+		"cert_not_after":   cert.NotAfter.Format(time.RFC3339),
+	}
+}
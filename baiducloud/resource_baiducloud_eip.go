@@ -23,6 +23,7 @@ $ terraform import baiducloud_eip.default eip
 package baiducloud
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/baidubce/bce-sdk-go/bce"
@@ -142,6 +143,18 @@ func resourceBaiduCloudEip() *schema.Resource {
 				ValidateFunc:     validation.StringInSlice([]string{"month", "year"}, false),
 				ConflictsWith:    []string{"reservation_length", "reservation_time_unit"},
 			},
+			"renew_length": {
+				Type:         schema.TypeInt,
+				Description:  "Renewal length of the Eip, which is only valid when the payment_timing is Prepaid. It is ignored on creation, and every change renews the Eip for the new length in place. Valid values: [1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36].",
+				Optional:     true,
+				ValidateFunc: validateReservationLength(),
+			},
+			"renew_time_unit": {
+				Type:         schema.TypeString,
+				Description:  "Renewal time unit of the Eip, which is only valid when the payment_timing is Prepaid. The value can only be month currently, which is also the default value.",
+				Optional:     true,
+				ValidateFunc: validateReservationUnit(),
+			},
 			"tags": tagsSchema(),
 		},
 	}
@@ -225,11 +238,14 @@ func resourceBaiduCloudEipUpdate(d *schema.ResourceData, meta interface{}) error
 	eipClient := EipService{client}
 
 	eipAddr := d.Id()
+	action := "Update EIP " + eipAddr
 	stateConf := buildStateConf(EIPProcessingStatus,
 		[]string{EIPStatusAvailable, EIPStatusBinded},
 		d.Timeout(schema.TimeoutUpdate),
 		eipClient.EipStateRefreshFunc(eipAddr, EIPFailedStatus))
 
+	d.Partial(true)
+
 	if d.HasChange("bandwidth_in_mbps") {
 
 		if err := eipClient.EipResizeBandwidth(eipAddr, d.Get("bandwidth_in_mbps").(int)); err != nil {
@@ -243,7 +259,7 @@ func resourceBaiduCloudEipUpdate(d *schema.ResourceData, meta interface{}) error
 		d.SetPartial("bandwidth_in_mbps")
 	}
 
-	if d.Get("billing_method").(string) == "Prepaid" &&
+	if d.Get("payment_timing").(string) == PAYMENT_TIMING_PREPAID &&
 		(d.HasChange("auto_renew_time") || d.HasChange("auto_renew_time_unit")) {
 		isStart, args := buildUpdateAutoRenewArgs(d)
 		if isStart {
@@ -255,6 +271,36 @@ func resourceBaiduCloudEipUpdate(d *schema.ResourceData, meta interface{}) error
 				return WrapError(err)
 			}
 		}
+
+		d.SetPartial("auto_renew_time")
+		d.SetPartial("auto_renew_time_unit")
+	}
+
+	if d.HasChange("renew_length") {
+		renewLength := d.Get("renew_length").(int)
+		if renewLength > 0 {
+			if d.Get("payment_timing").(string) != PAYMENT_TIMING_PREPAID {
+				return WrapErrorf(fmt.Errorf("Only Prepaid EIP can be renewed."), DefaultErrorMsg, "baiducloud_eip", action, BCESDKGoERROR)
+			}
+
+			args := &eip.PurchaseReservedEipArgs{
+				ClientToken: buildClientToken(),
+				Billing: &eip.Billing{
+					Reservation: &eip.Reservation{
+						ReservationLength:   renewLength,
+						ReservationTimeUnit: "month",
+					},
+				},
+			}
+			if v := d.Get("renew_time_unit").(string); v != "" {
+				args.Billing.Reservation.ReservationTimeUnit = v
+			}
+			if err := eipClient.PurchaseReservedEip(eipAddr, args); err != nil {
+				return err
+			}
+		}
+
+		d.SetPartial("renew_length")
 	}
 
 	d.Partial(false)
@@ -8,6 +8,7 @@ resource "baiducloud_eip_association" "default" {
   eip           = "1.1.1.1"
   instance_type = "BCC"
   instance_id   = "i-7xc9Q6KR"
+  direct        = true
 }
 ```
 
@@ -22,6 +23,7 @@ $ terraform import baiducloud_eip_association.default eip
 package baiducloud
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/baidubce/bce-sdk-go/bce"
@@ -36,6 +38,7 @@ func resourceBaiduCloudEipAssociation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBaiduCloudEipAssociationCreate,
 		Read:   resourceBaiduCloudEipAssociationRead,
+		Update: resourceBaiduCloudEipAssociationUpdate,
 		Delete: resourceBaiduCloudEipAssociationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -57,7 +60,7 @@ func resourceBaiduCloudEipAssociation() *schema.Resource {
 			},
 			"instance_type": {
 				Type:         schema.TypeString,
-				Description:  "Instance type which need to associate with EIP, support BCC/BLB/NAT/VPN",
+				Description:  "Instance type which need to associate with EIP, support BCC/BLB/NAT/VPN, BLB is used for both classic BLB and APPBLB",
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice([]string{"BCC", "BLB", "NAT", "VPN"}, false),
@@ -68,6 +71,12 @@ func resourceBaiduCloudEipAssociation() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			"direct": {
+				Type:        schema.TypeBool,
+				Description: "Whether to enable the EIP direct (passthrough) mode, which shows the public ip on the network interface of the instance, only support BCC instance. Changing it enables or disables the direct mode in place.",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 	}
 }
@@ -81,6 +90,10 @@ func resourceBaiduCloudEipAssociationCreate(d *schema.ResourceData, meta interfa
 	instanceType := d.Get("instance_type").(string)
 	action := "Bind EIP " + eipAddress + " with " + instanceId
 
+	if d.Get("direct").(bool) && instanceType != "BCC" {
+		return WrapErrorf(fmt.Errorf("EIP direct mode only support BCC instance"), DefaultErrorMsg, "baiducloud_eip_association", action, BCESDKGoERROR)
+	}
+
 	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		errDelete := eipClient.EipBind(eipAddress, instanceType, instanceId)
 		addDebug(action, errDelete)
@@ -108,6 +121,13 @@ func resourceBaiduCloudEipAssociationCreate(d *schema.ResourceData, meta interfa
 		return WrapError(err)
 	}
 
+	if d.Get("direct").(bool) {
+		action = "Enable EIP direct " + eipAddress
+		if err := eipClient.EipDirect(eipAddress, true); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eip_association", action, BCESDKGoERROR)
+		}
+	}
+
 	return resourceBaiduCloudEipAssociationRead(d, meta)
 }
 
@@ -129,6 +149,33 @@ func resourceBaiduCloudEipAssociationRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
+func resourceBaiduCloudEipAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	eipClient := EipService{client}
+
+	eipAddress := d.Id()
+	action := "Update EIP " + eipAddress + " association"
+
+	d.Partial(true)
+
+	if d.HasChange("direct") {
+		direct := d.Get("direct").(bool)
+		if direct && d.Get("instance_type").(string) != "BCC" {
+			return WrapErrorf(fmt.Errorf("EIP direct mode only support BCC instance"), DefaultErrorMsg, "baiducloud_eip_association", action, BCESDKGoERROR)
+		}
+
+		if err := eipClient.EipDirect(eipAddress, direct); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eip_association", action, BCESDKGoERROR)
+		}
+
+		d.SetPartial("direct")
+	}
+
+	d.Partial(false)
+
+	return resourceBaiduCloudEipAssociationRead(d, meta)
+}
+
 func resourceBaiduCloudEipAssociationDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
 	eipClient := EipService{client}
@@ -144,6 +191,12 @@ func resourceBaiduCloudEipAssociationDelete(d *schema.ResourceData, meta interfa
 		return nil
 	}
 
+	if d.Get("direct").(bool) {
+		if err := eipClient.EipDirect(eipAddress, false); err != nil && !NotFoundError(err) {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eip_association", "Disable EIP direct "+eipAddress, BCESDKGoERROR)
+		}
+	}
+
 	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		errDelete := eipClient.EipUnBind(eipAddress)
 		addDebug(action, errDelete)
@@ -80,6 +80,37 @@ func TestAccBaiduCloudEipAssociate(t *testing.T) {
 				ResourceName:      testAccEipAssociationResourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				// direct mode can not be queried from the EIP detail
+				ImportStateVerifyIgnore: []string{"direct"},
+			},
+		},
+	})
+}
+
+//lintignore:AT003
+func TestAccBaiduCloudEipAssociate_Direct(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccEIPAssociateDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEipAssociateDirectConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccEipAssociationResourceName),
+					resource.TestCheckResourceAttr(testAccEipAssociationResourceName, "instance_type", "BCC"),
+					resource.TestCheckResourceAttr(testAccEipAssociationResourceName, "direct", "true"),
+				),
+			},
+			{
+				Config: testAccEipAssociateDirectConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccEipAssociationResourceName),
+					resource.TestCheckResourceAttr(testAccEipAssociationResourceName, "direct", "false"),
+				),
 			},
 		},
 	})
@@ -170,3 +201,42 @@ resource "baiducloud_eip_association" "default" {
 		BaiduCloudTestResourceAttrNamePrefix+"EIP",
 		BaiduCloudTestResourceAttrNamePrefix+"APPBLB")
 }
+
+func testAccEipAssociateDirectConfig(direct bool) string {
+	return fmt.Sprintf(`
+data "baiducloud_specs" "default" {}
+
+data "baiducloud_zones" "default" {}
+
+data "baiducloud_images" "default" {
+  image_type = "System"
+}
+
+resource "baiducloud_instance" "default" {
+  name                  = "%s"
+  image_id              = data.baiducloud_images.default.images.0.id
+  availability_zone     = data.baiducloud_zones.default.zones.0.zone_name
+  cpu_count             = data.baiducloud_specs.default.specs.0.cpu_count
+  memory_capacity_in_gb = data.baiducloud_specs.default.specs.0.memory_size_in_gb
+  billing = {
+    payment_timing = "Postpaid"
+  }
+}
+
+resource "baiducloud_eip" "default" {
+  name              = "%s"
+  bandwidth_in_mbps = 1
+  payment_timing    = "Postpaid"
+  billing_method    = "ByTraffic"
+}
+
+resource "baiducloud_eip_association" "default" {
+  eip           = baiducloud_eip.default.id
+  instance_type = "BCC"
+  instance_id   = baiducloud_instance.default.id
+  direct        = %t
+}
+`, BaiduCloudTestResourceAttrNamePrefix+"BCC",
+		BaiduCloudTestResourceAttrNamePrefix+"EIP",
+		direct)
+}
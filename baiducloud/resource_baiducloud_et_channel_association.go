@@ -0,0 +1,257 @@
+/*
+Provide a resource to bind an ET Gateway with an ET channel of a dedicated line, which connects the VPC of the ET gateway
+with the IDC.
+
+~> **NOTE:** This resource binds a dedicated line channel, not a VPC. An ET gateway is bound with its VPC when it is
+created, by `vpc_id` of baiducloud_et_gateway, and cannot be moved to another VPC.
+
+Example Usage
+
+```hcl
+resource "baiducloud_et_channel_association" "default" {
+  et_gateway_id = "dcgw-iiyc0ers2qx4"
+  et_id         = "dcphy-jy1sbnx32ez0"
+  channel_id    = "dedicatedconn-zy9t7n91k0iq"
+  local_cidrs   = ["192.168.0.0/20"]
+}
+```
+
+Import
+
+ET channel association can be imported, e.g.
+
+```hcl
+$ terraform import baiducloud_et_channel_association.default et_gateway_id
+```
+*/
+package baiducloud
+
+import (
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/services/etGateway"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudEtChannelAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudEtChannelAssociationCreate,
+		Read:   resourceBaiduCloudEtChannelAssociationRead,
+		Update: resourceBaiduCloudEtChannelAssociationUpdate,
+		Delete: resourceBaiduCloudEtChannelAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"et_gateway_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the ET gateway.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"et_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the ET dedicated line.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"channel_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the ET channel of the dedicated line.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"local_cidrs": {
+				Type:        schema.TypeList,
+				Description: "Local CIDRs of the VPC announced to the IDC through the ET channel.",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.CIDRNetwork(0, 32),
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Status of the ET gateway.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudEtChannelAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	etService := EtService{client}
+
+	etGatewayId := d.Get("et_gateway_id").(string)
+	args := &etGateway.BindEtArgs{
+		ClientToken: buildClientToken(),
+		EtGatewayId: etGatewayId,
+		EtId:        d.Get("et_id").(string),
+		ChannelId:   d.Get("channel_id").(string),
+		LocalCidrs:  expandEtGatewayLocalCidrs(d.Get("local_cidrs").([]interface{})),
+	}
+	action := "Bind ET Gateway " + etGatewayId + " with ET channel " + args.ChannelId
+
+	if err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err := client.WithEtGatewayClient(func(etClient *etGateway.Client) (i interface{}, e error) {
+			return nil, etClient.BindEt(args)
+		})
+		addDebug(action, args)
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	}); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_channel_association", action, BCESDKGoERROR)
+	}
+
+	d.SetId(etGatewayId)
+
+	stateConf := buildStateConf(
+		append(EtGatewayProcessingStatus, ET_GATEWAY_STATUS_UNBOUND),
+		[]string{ET_GATEWAY_STATUS_RUNNING},
+		d.Timeout(schema.TimeoutCreate),
+		etService.EtGatewayStateRefresh(etGatewayId),
+	)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_channel_association", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudEtChannelAssociationRead(d, meta)
+}
+
+func resourceBaiduCloudEtChannelAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	etService := EtService{client}
+
+	etGatewayId := d.Id()
+	action := "Query ET Gateway " + etGatewayId + " channel association"
+
+	result, err := etService.GetEtGatewayDetail(etGatewayId)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_channel_association", action, BCESDKGoERROR)
+	}
+	// the ET gateway has been unbound outside terraform
+	if result.ChannelId == "" {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("et_gateway_id", result.EtGatewayId)
+	d.Set("et_id", result.EtId)
+	d.Set("channel_id", result.ChannelId)
+	d.Set("local_cidrs", result.LocalCidrs)
+	d.Set("status", result.Status)
+
+	return nil
+}
+
+func resourceBaiduCloudEtChannelAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	etService := EtService{client}
+
+	etGatewayId := d.Id()
+	action := "Update ET Gateway " + etGatewayId + " channel association"
+
+	if d.HasChange("local_cidrs") {
+		// local cidrs can only be updated when the ET gateway is running
+		stateConf := buildStateConf(
+			EtGatewayProcessingStatus,
+			[]string{ET_GATEWAY_STATUS_RUNNING},
+			d.Timeout(schema.TimeoutUpdate),
+			etService.EtGatewayStateRefresh(etGatewayId),
+		)
+		if _, err := stateConf.WaitForState(); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_channel_association", action, BCESDKGoERROR)
+		}
+
+		args := &etGateway.UpdateEtGatewayArgs{
+			ClientToken: buildClientToken(),
+			EtGatewayId: etGatewayId,
+			LocalCidrs:  expandEtGatewayLocalCidrs(d.Get("local_cidrs").([]interface{})),
+		}
+		_, err := client.WithEtGatewayClient(func(etClient *etGateway.Client) (i interface{}, e error) {
+			return nil, etClient.UpdateEtGateway(args)
+		})
+		addDebug(action, args)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_channel_association", action, BCESDKGoERROR)
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_channel_association", action, BCESDKGoERROR)
+		}
+	}
+
+	return resourceBaiduCloudEtChannelAssociationRead(d, meta)
+}
+
+func resourceBaiduCloudEtChannelAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	etService := EtService{client}
+
+	etGatewayId := d.Id()
+	action := "Unbind ET Gateway " + etGatewayId
+
+	clientToken := buildClientToken()
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := client.WithEtGatewayClient(func(etClient *etGateway.Client) (i interface{}, e error) {
+			return nil, etClient.UnBindEt(etGatewayId, clientToken)
+		})
+		addDebug(action, err)
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_channel_association", action, BCESDKGoERROR)
+	}
+
+	stateConf := buildStateConf(
+		append(EtGatewayProcessingStatus, ET_GATEWAY_STATUS_RUNNING),
+		[]string{ET_GATEWAY_STATUS_UNBOUND, ET_GATEWAY_STATUS_DELETED},
+		d.Timeout(schema.TimeoutDelete),
+		etService.EtGatewayStateRefresh(etGatewayId),
+	)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_channel_association", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func expandEtGatewayLocalCidrs(cidrs []interface{}) []string {
+	localCidrs := make([]string, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		localCidrs = append(localCidrs, cidr.(string))
+	}
+	return localCidrs
+}
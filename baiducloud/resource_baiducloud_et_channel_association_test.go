@@ -0,0 +1,109 @@
+package baiducloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	testAccEtChannelAssociationResourceType = "baiducloud_et_channel_association"
+	testAccEtChannelAssociationResourceName = testAccEtChannelAssociationResourceType + "." + BaiduCloudTestResourceName
+)
+
+// ET dedicated lines can not be created by the openapi, the test needs an existing line and channel
+func testAccEtChannelAssociationPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	if os.Getenv("BAIDUCLOUD_ET_ID") == "" || os.Getenv("BAIDUCLOUD_ET_CHANNEL_ID") == "" {
+		t.Skip("BAIDUCLOUD_ET_ID and BAIDUCLOUD_ET_CHANNEL_ID must be set for ET channel association acceptance tests")
+	}
+}
+
+//lintignore:AT003
+func TestAccBaiduCloudEtChannelAssociation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccEtChannelAssociationPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccEtChannelAssociationDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEtChannelAssociationConfig("192.168.0.0/20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccEtChannelAssociationResourceName),
+					resource.TestCheckResourceAttr(testAccEtChannelAssociationResourceName, "et_id", os.Getenv("BAIDUCLOUD_ET_ID")),
+					resource.TestCheckResourceAttr(testAccEtChannelAssociationResourceName, "channel_id", os.Getenv("BAIDUCLOUD_ET_CHANNEL_ID")),
+					resource.TestCheckResourceAttr(testAccEtChannelAssociationResourceName, "local_cidrs.#", "1"),
+					resource.TestCheckResourceAttr(testAccEtChannelAssociationResourceName, "status", ET_GATEWAY_STATUS_RUNNING),
+				),
+			},
+			{
+				ResourceName:      testAccEtChannelAssociationResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEtChannelAssociationConfig("192.168.16.0/20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccEtChannelAssociationResourceName),
+					resource.TestCheckResourceAttr(testAccEtChannelAssociationResourceName, "local_cidrs.0", "192.168.16.0/20"),
+					resource.TestCheckResourceAttr(testAccEtChannelAssociationResourceName, "status", ET_GATEWAY_STATUS_RUNNING),
+				),
+			},
+		},
+	})
+}
+
+func testAccEtChannelAssociationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.BaiduClient)
+	etService := &EtService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccEtChannelAssociationResourceType {
+			continue
+		}
+
+		result, err := etService.GetEtGatewayDetail(rs.Primary.ID)
+		if err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return WrapError(err)
+		}
+		if result.ChannelId != "" {
+			return WrapError(Error("ET channel association still exist"))
+		}
+	}
+
+	return nil
+}
+
+func testAccEtChannelAssociationConfig(localCidr string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_vpc" "default" {
+  name = "%s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "baiducloud_et_gateway" "default" {
+  name   = "%s"
+  vpc_id = baiducloud_vpc.default.id
+  speed  = 100
+}
+
+resource "baiducloud_et_channel_association" "default" {
+  et_gateway_id = baiducloud_et_gateway.default.id
+  et_id         = "%s"
+  channel_id    = "%s"
+  local_cidrs   = ["%s"]
+}
+`, BaiduCloudTestResourceAttrNamePrefix+"VPC", testAccEtGatewayResourceAttrName,
+		os.Getenv("BAIDUCLOUD_ET_ID"), os.Getenv("BAIDUCLOUD_ET_CHANNEL_ID"), localCidr)
+}
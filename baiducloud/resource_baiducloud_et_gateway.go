@@ -0,0 +1,252 @@
+/*
+Provide a resource to create an ET Gateway, the VPC side gateway of an ET dedicated line. The ET gateway is bound with
+the VPC given by vpc_id when it is created. Bind it with an ET channel by baiducloud_et_channel_association, and route
+the VPC traffic to it by baiducloud_route_rule with next_hop_type dcGateway.
+
+Example Usage
+
+```hcl
+resource "baiducloud_et_gateway" "default" {
+  name        = "terraform-et-gateway"
+  vpc_id      = "vpc-ggm7drdgyvha"
+  speed       = 100
+  description = "IDC"
+}
+```
+
+Import
+
+ET Gateway instance can be imported, e.g.
+
+```hcl
+$ terraform import baiducloud_et_gateway.default et_gateway_id
+```
+*/
+package baiducloud
+
+import (
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/services/etGateway"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudEtGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudEtGatewayCreate,
+		Read:   resourceBaiduCloudEtGatewayRead,
+		Update: resourceBaiduCloudEtGatewayUpdate,
+		Delete: resourceBaiduCloudEtGatewayDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the ET gateway, consisting of uppercase and lowercase letters、numbers and special characters, such as \"-\",\"_\",\"/\",\".\". The value must start with a letter, and the length should between 1-65.",
+				Required:    true,
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Description: "VPC ID of the ET gateway.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"speed": {
+				Type:         schema.TypeInt,
+				Description:  "Bandwidth of the ET gateway in Mbps, which should not exceed the bandwidth of the ET channel.",
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the ET gateway.",
+				Optional:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Status of the ET gateway.",
+				Computed:    true,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Description: "Create time of the ET gateway.",
+				Computed:    true,
+			},
+			"et_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the ET dedicated line bound with the ET gateway.",
+				Computed:    true,
+			},
+			"channel_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the ET channel bound with the ET gateway.",
+				Computed:    true,
+			},
+			"local_cidrs": {
+				Type:        schema.TypeList,
+				Description: "Local CIDRs of the ET gateway, which are announced to the IDC through the ET channel.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudEtGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	etService := EtService{client}
+
+	args := &etGateway.CreateEtGatewayArgs{
+		ClientToken: buildClientToken(),
+		Name:        d.Get("name").(string),
+		VpcId:       d.Get("vpc_id").(string),
+		Speed:       d.Get("speed").(int),
+		Description: d.Get("description").(string),
+	}
+	action := "Create ET Gateway " + args.Name
+
+	if err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		raw, err := client.WithEtGatewayClient(func(etClient *etGateway.Client) (i interface{}, e error) {
+			return etClient.CreateEtGateway(args)
+		})
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(action, raw)
+		result, _ := raw.(*etGateway.CreateEtGatewayResult)
+		d.SetId(result.EtGatewayId)
+		return nil
+	}); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_gateway", action, BCESDKGoERROR)
+	}
+
+	stateConf := buildStateConf(
+		EtGatewayProcessingStatus,
+		EtGatewayAvailableStatus,
+		d.Timeout(schema.TimeoutCreate),
+		etService.EtGatewayStateRefresh(d.Id()),
+	)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_gateway", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudEtGatewayRead(d, meta)
+}
+
+func resourceBaiduCloudEtGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	etService := EtService{client}
+
+	etGatewayId := d.Id()
+	action := "Query ET Gateway " + etGatewayId
+
+	result, err := etService.GetEtGatewayDetail(etGatewayId)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_gateway", action, BCESDKGoERROR)
+	}
+
+	d.Set("name", result.Name)
+	d.Set("vpc_id", result.VpcId)
+	d.Set("speed", result.Speed)
+	d.Set("description", result.Description)
+	d.Set("status", result.Status)
+	d.Set("create_time", result.CreateTime)
+	d.Set("et_id", result.EtId)
+	d.Set("channel_id", result.ChannelId)
+	d.Set("local_cidrs", result.LocalCidrs)
+
+	return nil
+}
+
+func resourceBaiduCloudEtGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	etService := EtService{client}
+
+	etGatewayId := d.Id()
+	action := "Update ET Gateway " + etGatewayId
+
+	if d.HasChange("name") || d.HasChange("speed") || d.HasChange("description") {
+		args := &etGateway.UpdateEtGatewayArgs{
+			ClientToken: buildClientToken(),
+			EtGatewayId: etGatewayId,
+			Name:        d.Get("name").(string),
+			Speed:       d.Get("speed").(int),
+			Description: d.Get("description").(string),
+		}
+
+		_, err := client.WithEtGatewayClient(func(etClient *etGateway.Client) (i interface{}, e error) {
+			return nil, etClient.UpdateEtGateway(args)
+		})
+		addDebug(action, args)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_gateway", action, BCESDKGoERROR)
+		}
+
+		stateConf := buildStateConf(
+			EtGatewayProcessingStatus,
+			EtGatewayAvailableStatus,
+			d.Timeout(schema.TimeoutUpdate),
+			etService.EtGatewayStateRefresh(etGatewayId),
+		)
+		if _, err := stateConf.WaitForState(); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_gateway", action, BCESDKGoERROR)
+		}
+	}
+
+	return resourceBaiduCloudEtGatewayRead(d, meta)
+}
+
+func resourceBaiduCloudEtGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	etService := EtService{client}
+
+	etGatewayId := d.Id()
+	action := "Delete ET Gateway " + etGatewayId
+
+	clientToken := buildClientToken()
+	_, err := client.WithEtGatewayClient(func(etClient *etGateway.Client) (i interface{}, e error) {
+		return nil, etClient.DeleteEtGateway(etGatewayId, clientToken)
+	})
+	addDebug(action, nil)
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_gateway", action, BCESDKGoERROR)
+	}
+
+	stateConf := buildStateConf(
+		append(append([]string{ET_GATEWAY_STATUS_DELETING}, EtGatewayProcessingStatus...), EtGatewayAvailableStatus...),
+		[]string{ET_GATEWAY_STATUS_DELETED},
+		d.Timeout(schema.TimeoutDelete),
+		etService.EtGatewayStateRefresh(etGatewayId),
+	)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_gateway", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	testAccEtGatewayResourceType     = "baiducloud_et_gateway"
+	testAccEtGatewayResourceName     = testAccEtGatewayResourceType + "." + BaiduCloudTestResourceName
+	testAccEtGatewayResourceAttrName = BaiduCloudTestResourceAttrNamePrefix + "EtGateway"
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudEtGateway(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccEtGatewayDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEtGatewayConfig(testAccEtGatewayResourceAttrName, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccEtGatewayResourceName),
+					resource.TestCheckResourceAttr(testAccEtGatewayResourceName, "name", testAccEtGatewayResourceAttrName),
+					resource.TestCheckResourceAttr(testAccEtGatewayResourceName, "speed", "100"),
+					resource.TestCheckResourceAttr(testAccEtGatewayResourceName, "description", "created by terraform"),
+					resource.TestCheckResourceAttr(testAccEtGatewayResourceName, "status", ET_GATEWAY_STATUS_UNBOUND),
+					resource.TestCheckResourceAttrSet(testAccEtGatewayResourceName, "vpc_id"),
+					resource.TestCheckResourceAttrSet(testAccEtGatewayResourceName, "create_time"),
+				),
+			},
+			{
+				ResourceName:      testAccEtGatewayResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEtGatewayConfig(testAccEtGatewayResourceAttrName+"-update", 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccEtGatewayResourceName),
+					resource.TestCheckResourceAttr(testAccEtGatewayResourceName, "name", testAccEtGatewayResourceAttrName+"-update"),
+					resource.TestCheckResourceAttr(testAccEtGatewayResourceName, "speed", "200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEtGatewayDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.BaiduClient)
+	etService := &EtService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccEtGatewayResourceType {
+			continue
+		}
+
+		_, err := etService.GetEtGatewayDetail(rs.Primary.ID)
+		if err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return WrapError(err)
+		}
+		return WrapError(Error("EtGateway still exist"))
+	}
+
+	return nil
+}
+
+func testAccEtGatewayConfig(name string, speed int) string {
+	return fmt.Sprintf(`
+resource "baiducloud_vpc" "default" {
+  name = "%s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "baiducloud_et_gateway" "default" {
+  name        = "%s"
+  vpc_id      = baiducloud_vpc.default.id
+  speed       = %d
+  description = "created by terraform"
+}
+`, BaiduCloudTestResourceAttrNamePrefix+"VPC", name, speed)
+}
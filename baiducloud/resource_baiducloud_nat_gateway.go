@@ -8,6 +8,7 @@ resource "baiducloud_nat_gateway" "default" {
   name = "terraform-nat-gateway"
   vpc_id = "vpc-ggm7drdgyvha"
   spec = "medium"
+  eips = ["180.76.xx.xx"]
   billing = {
     payment_timing = "Postpaid"
   }
@@ -87,12 +88,25 @@ func resourceBaiduCloudNatGateway() *schema.Resource {
 			},
 			"eips": {
 				Type:        schema.TypeSet,
-				Description: "One public network EIP associated with the NAT gateway or one or more EIPs in the shared bandwidth.",
+				Description: "One public network EIP associated with the NAT gateway or one or more EIPs in the shared bandwidth. Changing it binds or unbinds EIPs in place.",
+				Optional:    true,
 				Computed:    true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"renew_length": {
+				Type:         schema.TypeInt,
+				Description:  "Renewal length of the NAT gateway, which is only valid when the payment_timing is Prepaid. It is ignored on creation, and every change renews the NAT gateway for the new length in place. Valid values: [1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36].",
+				Optional:     true,
+				ValidateFunc: validateReservationLength(),
+			},
+			"renew_time_unit": {
+				Type:         schema.TypeString,
+				Description:  "Renewal time unit of the NAT gateway, which is only valid when the payment_timing is Prepaid. The value can only be month currently, which is also the default value.",
+				Optional:     true,
+				ValidateFunc: validateReservationUnit(),
+			},
 			"billing": {
 				Type:        schema.TypeMap,
 				Description: "Billing information of the NAT gateway.",
@@ -223,6 +237,7 @@ func resourceBaiduCloudNatGatewayRead(d *schema.ResourceData, meta interface{})
 
 func resourceBaiduCloudNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
+	vpcService := VpcService{client}
 
 	natId := d.Id()
 	action := "Update NAT Gateway " + natId
@@ -245,6 +260,83 @@ func resourceBaiduCloudNatGatewayUpdate(d *schema.ResourceData, meta interface{}
 		d.SetPartial("name")
 	}
 
+	if d.HasChange("eips") {
+		o, n := d.GetChange("eips")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		remove := expandStringSet(os.Difference(ns))
+		add := expandStringSet(ns.Difference(os))
+
+		if len(remove) > 0 {
+			args := &vpc.UnBindEipsArgs{
+				ClientToken: buildClientToken(),
+				Eips:        remove,
+			}
+			_, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
+				return nil, vpcClient.UnBindEips(natId, args)
+			})
+			addDebug(action, remove)
+			if err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_nat_gateway", action, BCESDKGoERROR)
+			}
+
+			if err := waitNatGatewayConfigured(d, vpcService); err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_nat_gateway", action, BCESDKGoERROR)
+			}
+		}
+
+		if len(add) > 0 {
+			args := &vpc.BindEipsArgs{
+				ClientToken: buildClientToken(),
+				Eips:        add,
+			}
+			_, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
+				return nil, vpcClient.BindEips(natId, args)
+			})
+			addDebug(action, add)
+			if err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_nat_gateway", action, BCESDKGoERROR)
+			}
+
+			if err := waitNatGatewayConfigured(d, vpcService); err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_nat_gateway", action, BCESDKGoERROR)
+			}
+		}
+
+		d.SetPartial("eips")
+	}
+
+	if d.HasChange("renew_length") {
+		renewLength := d.Get("renew_length").(int)
+		if renewLength > 0 {
+			paymentTiming := d.Get("billing").(map[string]interface{})["payment_timing"]
+			if paymentTiming != PAYMENT_TIMING_PREPAID {
+				return WrapErrorf(fmt.Errorf("Only Prepaid NAT gateway can be renewed."), DefaultErrorMsg, "baiducloud_nat_gateway", action, BCESDKGoERROR)
+			}
+
+			args := &vpc.RenewNatGatewayArgs{
+				ClientToken: buildClientToken(),
+				Billing: &vpc.Billing{
+					Reservation: &vpc.Reservation{
+						ReservationLength:   renewLength,
+						ReservationTimeUnit: "month",
+					},
+				},
+			}
+			if v := d.Get("renew_time_unit").(string); v != "" {
+				args.Billing.Reservation.ReservationTimeUnit = v
+			}
+			_, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
+				return nil, vpcClient.RenewNatGateway(natId, args)
+			})
+			addDebug(action, args)
+			if err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_nat_gateway", action, BCESDKGoERROR)
+			}
+		}
+
+		d.SetPartial("renew_length")
+	}
+
 	d.Partial(false)
 
 	return resourceBaiduCloudNatGatewayRead(d, meta)
@@ -298,6 +390,9 @@ func buildBaiduCloudNatGatewayArgs(d *schema.ResourceData) *vpc.CreateNatGateway
 	if v := d.Get("spec").(string); v != "" {
 		args.Spec = vpc.NatGatewaySpecType(v)
 	}
+	if v, ok := d.GetOk("eips"); ok {
+		args.Eips = expandStringSet(v.(*schema.Set))
+	}
 
 	if v, ok := d.GetOk("billing"); ok {
 		billing := v.(map[string]interface{})
@@ -321,3 +416,16 @@ func buildBaiduCloudNatGatewayArgs(d *schema.ResourceData) *vpc.CreateNatGateway
 
 	return args
 }
+
+// waitNatGatewayConfigured waits for the NAT gateway to finish (re)binding EIPs.
+// A NAT gateway without any EIP stays unconfigured, so both states are accepted.
+func waitNatGatewayConfigured(d *schema.ResourceData, vpcService VpcService) error {
+	stateConf := buildStateConf(
+		[]string{string(vpc.NAT_STATUS_CONFIGURING), string(vpc.NAT_STATUS_STARTING)},
+		[]string{string(vpc.NAT_STATUS_ACTIVE), string(vpc.NAT_STATUS_UNCONFIGURED)},
+		d.Timeout(schema.TimeoutUpdate),
+		vpcService.NatGatewayStateRefresh(d.Id()),
+	)
+	_, err := stateConf.WaitForState()
+	return err
+}
@@ -53,6 +53,14 @@ func TestAccBaiduCloudNatGateway(t *testing.T) {
 					resource.TestCheckResourceAttrSet(testAccNatGatewayResourceName, "status"),
 				),
 			},
+			{
+				Config: testAccNatGatewayConfigBindEips(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccNatGatewayResourceName),
+					resource.TestCheckResourceAttr(testAccNatGatewayResourceName, "eips.#", "1"),
+					resource.TestCheckResourceAttr(testAccNatGatewayResourceName, "status", "active"),
+				),
+			},
 		},
 	})
 }
@@ -152,3 +160,42 @@ resource "baiducloud_eip_association" "default" {
 		BaiduCloudTestResourceAttrNamePrefix+"EIP",
 		testAccNatGatewayResourceAttrName+"-update")
 }
+
+func testAccNatGatewayConfigBindEips() string {
+	return fmt.Sprintf(`
+resource "baiducloud_vpc" "default" {
+  name = "%s"
+  cidr = "192.168.0.0/16"
+}
+
+data "baiducloud_zones" "default" {}
+
+resource "baiducloud_subnet" "default" {
+  name      = "%s"
+  zone_name = data.baiducloud_zones.default.zones.0.zone_name
+  cidr      = "192.168.1.0/24"
+  vpc_id    = baiducloud_vpc.default.id
+}
+
+resource "baiducloud_eip" "default" {
+  name              = "%s"
+  bandwidth_in_mbps = 1
+  payment_timing    = "Postpaid"
+  billing_method    = "ByTraffic"
+}
+
+resource "baiducloud_nat_gateway" "default" {
+  name   = "%s"
+  vpc_id = baiducloud_vpc.default.id
+  spec   = "medium"
+  eips   = [baiducloud_eip.default.eip]
+  billing = {
+    payment_timing = "Postpaid"
+  }
+  depends_on = ["baiducloud_subnet.default"]
+}
+`, BaiduCloudTestResourceAttrNamePrefix+"VPC",
+		BaiduCloudTestResourceAttrNamePrefix+"Subnet",
+		BaiduCloudTestResourceAttrNamePrefix+"EIP",
+		testAccNatGatewayResourceAttrName+"-update")
+}
@@ -26,6 +26,7 @@ $ terraform import baiducloud_peer_conn.default peer_conn_id
 package baiducloud
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -112,6 +113,18 @@ func resourceBaiduCloudPeerConn() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"renew_length": {
+				Type:         schema.TypeInt,
+				Description:  "Renewal length of the peer connection, which is only valid when the payment_timing is Prepaid. It is ignored on creation, and every change renews the peer connection for the new length in place. Valid values: [1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36].",
+				Optional:     true,
+				ValidateFunc: validateReservationLength(),
+			},
+			"renew_time_unit": {
+				Type:         schema.TypeString,
+				Description:  "Renewal time unit of the peer connection, which is only valid when the payment_timing is Prepaid. The value can only be month currently, which is also the default value.",
+				Optional:     true,
+				ValidateFunc: validateReservationUnit(),
+			},
 			"role": {
 				Type:        schema.TypeString,
 				Description: "Role of the peer connection, which can be initiator or acceptor.",
@@ -303,15 +316,7 @@ func resourceBaiduCloudPeerConnUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if d.HasChange("bandwidth_in_mbps") {
-		newBandwidthInMbps := d.Get("bandwidth_in_mbps").(int)
-		args := &vpc.ResizePeerConnArgs{
-			NewBandwidthInMbps: newBandwidthInMbps,
-		}
-		_, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
-			return nil, vpcClient.ResizePeerConn(peerConnId, args)
-		})
-		addDebug(action, err)
-		if err != nil {
+		if err := vpcService.ResizePeerConn(d, peerConnId, vpc.PEERCONN_ROLE_INITIATOR); err != nil {
 			if NotFoundError(err) {
 				d.SetId("")
 				return nil
@@ -319,16 +324,22 @@ func resourceBaiduCloudPeerConnUpdate(d *schema.ResourceData, meta interface{})
 			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_peer_conn", action, BCESDKGoERROR)
 		}
 
-		stateConf := buildStateConf(
-			[]string{string(vpc.PEERCONN_STATUS_UPDATING)},
-			[]string{string(vpc.PEERCONN_STATUS_ACTIVE), string(vpc.PEERCONN_STATUS_CONSULTING)},
-			d.Timeout(schema.TimeoutUpdate),
-			vpcService.PeerConnStateRefresh(d.Id(), vpc.PEERCONN_ROLE_INITIATOR))
-		if _, err := stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_peer_conn", action, BCESDKGoERROR)
+		d.SetPartial("bandwidth_in_mbps")
+	}
+
+	if d.HasChange("renew_length") {
+		if d.Get("renew_length").(int) > 0 {
+			paymentTiming := d.Get("billing").(map[string]interface{})["payment_timing"]
+			if paymentTiming != PAYMENT_TIMING_PREPAID {
+				return WrapErrorf(fmt.Errorf("Only Prepaid peer conn can be renewed."), DefaultErrorMsg, "baiducloud_peer_conn", action, BCESDKGoERROR)
+			}
+
+			if err := vpcService.RenewPeerConn(d, peerConnId); err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_peer_conn", action, BCESDKGoERROR)
+			}
 		}
 
-		d.SetPartial("bandwidth_in_mbps")
+		d.SetPartial("renew_length")
 	}
 
 	if d.HasChange("dns_sync") {
@@ -88,7 +88,8 @@ func resourceBaiduCloudPeerConnAcceptor() *schema.Resource {
 			},
 			"bandwidth_in_mbps": {
 				Type:        schema.TypeInt,
-				Description: "Bandwidth(Mbps) of the peer connection.",
+				Description: "Bandwidth(Mbps) of the peer connection. Changing it resizes the peer connection in place, which is only allowed when the peer connection is within this account.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"description": {
@@ -180,6 +181,9 @@ func resourceBaiduCloudPeerConnAcceptorCreate(d *schema.ResourceData, meta inter
 	peerConnID := d.Get("peer_conn_id").(string)
 	d.SetId(peerConnID)
 
+	// Read fills bandwidth_in_mbps with the current bandwidth, restore the configured one so that Update resizes it
+	bandwidth, resize := d.GetOk("bandwidth_in_mbps")
+
 	if err := resourceBaiduCloudPeerConnAcceptorRead(d, meta); err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_peer_conn_acceptor", action, BCESDKGoERROR)
 	}
@@ -189,6 +193,10 @@ func resourceBaiduCloudPeerConnAcceptorCreate(d *schema.ResourceData, meta inter
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_peer_conn_acceptor", action, BCESDKGoERROR)
 	}
 
+	if resize {
+		d.Set("bandwidth_in_mbps", bandwidth)
+	}
+
 	return resourceBaiduCloudPeerConnAcceptorUpdate(d, meta)
 }
 
@@ -264,9 +272,17 @@ func resourceBaiduCloudPeerConnAcceptorUpdate(d *schema.ResourceData, meta inter
 		d.SetPartial("dns_sync")
 	}
 
+	// compare with the peer conn as well, bandwidth_in_mbps always differs from the empty state on creation
+	if d.HasChange("bandwidth_in_mbps") && d.Get("bandwidth_in_mbps").(int) != peerConn.BandwidthInMbps {
+		if err := vpcService.ResizePeerConn(d, peerConnID, vpc.PEERCONN_ROLE_ACCEPTOR); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_peer_conn_acceptor", action, BCESDKGoERROR)
+		}
+		d.SetPartial("bandwidth_in_mbps")
+	}
+
 	d.Partial(false)
 
-	return resourceBaiduCloudPeerConnRead(d, meta)
+	return resourceBaiduCloudPeerConnAcceptorRead(d, meta)
 }
 
 func resourceBaiduCloudPeerConnAcceptorDelete(d *schema.ResourceData, meta interface{}) error {
@@ -26,7 +26,7 @@ func TestAccBaiduCloudPeerConnAcceptor(t *testing.T) {
 				Config: testAccPeerConnAcceptorConfig(),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBaiduCloudDataSourceId(testAccPeerConnAcceptorResourceName),
-					resource.TestCheckResourceAttr(testAccPeerConnAcceptorResourceName, "bandwidth_in_mbps", "20"),
+					resource.TestCheckResourceAttr(testAccPeerConnAcceptorResourceName, "bandwidth_in_mbps", "30"),
 					resource.TestCheckResourceAttr(testAccPeerConnAcceptorResourceName, "description", "test peer conn"),
 					resource.TestCheckResourceAttr(testAccPeerConnAcceptorResourceName, "local_if_name", "local-interface"),
 					resource.TestCheckResourceAttrSet(testAccPeerConnResourceName, "local_if_id"),
@@ -48,7 +48,7 @@ func TestAccBaiduCloudPeerConnAcceptor(t *testing.T) {
 				Config: testAccPeerConnAcceptorConfigUpdate(),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBaiduCloudDataSourceId(testAccPeerConnAcceptorResourceName),
-					resource.TestCheckResourceAttr(testAccPeerConnAcceptorResourceName, "bandwidth_in_mbps", "20"),
+					resource.TestCheckResourceAttr(testAccPeerConnAcceptorResourceName, "bandwidth_in_mbps", "40"),
 					resource.TestCheckResourceAttr(testAccPeerConnAcceptorResourceName, "description", "test peer conn"),
 					resource.TestCheckResourceAttr(testAccPeerConnAcceptorResourceName, "local_if_name", "local-interface"),
 					resource.TestCheckResourceAttrSet(testAccPeerConnResourceName, "local_if_id"),
@@ -89,12 +89,17 @@ resource "baiducloud_peer_conn" "default" {
   billing = {
     payment_timing = "Postpaid"
   }
+
+  lifecycle {
+    ignore_changes = [bandwidth_in_mbps]
+  }
 }
 
 resource "baiducloud_peer_conn_acceptor" "default" {
-  peer_conn_id = baiducloud_peer_conn.default.id
-  auto_accept  = true
-  dns_sync     = false
+  peer_conn_id      = baiducloud_peer_conn.default.id
+  auto_accept       = true
+  dns_sync          = false
+  bandwidth_in_mbps = 30
 }
 `, BaiduCloudTestResourceAttrNamePrefix+"VPC-local",
 		BaiduCloudTestResourceAttrNamePrefix+"VPC-peer", region)
@@ -123,12 +128,17 @@ resource "baiducloud_peer_conn" "default" {
   billing = {
     payment_timing = "Postpaid"
   }
+
+  lifecycle {
+    ignore_changes = [bandwidth_in_mbps]
+  }
 }
 
 resource "baiducloud_peer_conn_acceptor" "default" {
-  peer_conn_id = baiducloud_peer_conn.default.id
-  auto_accept  = true
-  dns_sync     = true
+  peer_conn_id      = baiducloud_peer_conn.default.id
+  auto_accept       = true
+  dns_sync          = true
+  bandwidth_in_mbps = 40
 }
 `, BaiduCloudTestResourceAttrNamePrefix+"VPC-local",
 		BaiduCloudTestResourceAttrNamePrefix+"VPC-peer", region)
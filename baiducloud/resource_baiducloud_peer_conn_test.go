@@ -123,6 +123,38 @@ func TestAccBaiduCloudPeerConn(t *testing.T) {
 	})
 }
 
+//lintignore:AT003
+func TestAccBaiduCloudPeerConnPrepaid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccPeerConnDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPeerConnPrepaidConfig(20, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccPeerConnResourceName),
+					resource.TestCheckResourceAttr(testAccPeerConnResourceName, "bandwidth_in_mbps", "20"),
+					resource.TestCheckResourceAttr(testAccPeerConnResourceName, "billing.payment_timing", PAYMENT_TIMING_PREPAID),
+					resource.TestCheckResourceAttrSet(testAccPeerConnResourceName, "expired_time"),
+				),
+			},
+			{
+				Config: testAccPeerConnPrepaidConfig(30, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccPeerConnResourceName),
+					resource.TestCheckResourceAttr(testAccPeerConnResourceName, "bandwidth_in_mbps", "30"),
+					resource.TestCheckResourceAttr(testAccPeerConnResourceName, "renew_length", "1"),
+					resource.TestCheckResourceAttrSet(testAccPeerConnResourceName, "expired_time"),
+				),
+			},
+		},
+	})
+}
+
 func testAccPeerConnDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.BaiduClient)
 	vpcService := &VpcService{client}
@@ -206,3 +238,40 @@ resource "baiducloud_peer_conn" "default" {
 		BaiduCloudTestResourceAttrNamePrefix+"VPC-peer", region,
 		BaiduCloudTestResourceAttrNamePrefix+"PeerConnUpdate")
 }
+
+func testAccPeerConnPrepaidConfig(bandwidth, renewLength int) string {
+	region := os.Getenv("BAIDUCLOUD_REGION")
+	renew := ""
+	if renewLength > 0 {
+		renew = fmt.Sprintf("renew_length      = %d", renewLength)
+	}
+	return fmt.Sprintf(`
+resource "baiducloud_vpc" "local-vpc" {
+  name = "%s"
+  cidr = "172.17.0.0/16"
+}
+
+resource "baiducloud_vpc" "peer-vpc" {
+  name = "%s"
+  cidr = "172.18.0.0/16"
+}
+
+resource "baiducloud_peer_conn" "default" {
+  bandwidth_in_mbps = %d
+  local_vpc_id      = baiducloud_vpc.local-vpc.id
+  peer_vpc_id       = baiducloud_vpc.peer-vpc.id
+  peer_region       = "%s"
+  description       = "%s"
+  %s
+  billing = {
+    payment_timing = "Prepaid"
+    reservation = {
+      reservation_length    = 1
+      reservation_time_unit = "month"
+    }
+  }
+}
+`, BaiduCloudTestResourceAttrNamePrefix+"VPC-local",
+		BaiduCloudTestResourceAttrNamePrefix+"VPC-peer", bandwidth, region,
+		BaiduCloudTestResourceAttrNamePrefix+"PeerConnPrepaid", renew)
+}
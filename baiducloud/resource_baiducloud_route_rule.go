@@ -70,10 +70,10 @@ func resourceBaiduCloudRouteRule() *schema.Resource {
 			},
 			"next_hop_type": {
 				Type:         schema.TypeString,
-				Description:  "Type of the next hop, available values are custom、vpn and nat.",
+				Description:  "Type of the next hop, available values are custom、vpn、nat and dcGateway.",
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"custom", "vpn", "nat"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"custom", "vpn", "nat", "dcGateway"}, false),
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -92,6 +92,11 @@ func resourceBaiduCloudSubnet() *schema.Resource {
 				Description: "Description of the subnet, and the value must be no more than 200 characters.",
 				Optional:    true,
 			},
+			"available_ip_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of available IP addresses in the subnet.",
+				Computed:    true,
+			},
 			"tags": tagsSchema(),
 		},
 	}
@@ -150,6 +155,7 @@ func resourceBaiduCloudSubnetRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("vpc_id", result.Subnet.VPCId)
 	d.Set("subnet_type", result.Subnet.SubnetType)
 	d.Set("description", result.Subnet.Description)
+	d.Set("available_ip_count", result.Subnet.AvailableIp)
 	d.Set("tags", flattenTagsToMap(result.Subnet.Tags))
 
 	return nil
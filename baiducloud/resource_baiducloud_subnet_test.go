@@ -34,6 +34,7 @@ func TestAccBaiduCloudSubnet(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccSubnetResourceName, "cidr", "192.168.3.0/24"),
 					resource.TestCheckResourceAttr(testAccSubnetResourceName, "description", "test"),
 					resource.TestCheckResourceAttr(testAccSubnetResourceName, "subnet_type", "BCC"),
+					resource.TestCheckResourceAttrSet(testAccSubnetResourceName, "available_ip_count"),
 					resource.TestCheckResourceAttrSet(testAccSubnetResourceName, "vpc_id"),
 					resource.TestCheckResourceAttrSet(testAccSubnetResourceName, "zone_name"),
 					resource.TestCheckResourceAttr(testAccSubnetResourceName, "tags.%", "1"),
@@ -52,6 +53,7 @@ func TestAccBaiduCloudSubnet(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccSubnetResourceName, "cidr", "192.168.3.0/24"),
 					resource.TestCheckResourceAttr(testAccSubnetResourceName, "description", "test update"),
 					resource.TestCheckResourceAttr(testAccSubnetResourceName, "subnet_type", "BCC"),
+					resource.TestCheckResourceAttrSet(testAccSubnetResourceName, "available_ip_count"),
 					resource.TestCheckResourceAttrSet(testAccSubnetResourceName, "vpc_id"),
 					resource.TestCheckResourceAttrSet(testAccSubnetResourceName, "zone_name"),
 					resource.TestCheckResourceAttr(testAccSubnetResourceName, "tags.%", "1"),
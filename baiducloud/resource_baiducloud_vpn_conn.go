@@ -0,0 +1,489 @@
+/*
+Provide a resource to create a VPN Conn, which connects a VPN gateway to the remote (customer) gateway with IPSec.
+The remote side is described by remote_ip and remote_subnets.
+
+Example Usage
+
+```hcl
+resource "baiducloud_vpn_conn" "default" {
+  vpn_id         = "vpn-shyt3wb0g0d4"
+  vpn_conn_name  = "terraform-vpn-conn"
+  secret_key     = "ddd22@www"
+  local_subnets  = ["192.168.0.0/20"]
+  remote_ip      = "11.11.11.133"
+  remote_subnets = ["192.168.100.0/24"]
+  description    = "office"
+
+  ike_config {
+    ike_version  = "v1"
+    ike_mode     = "main"
+    ike_enc_alg  = "aes"
+    ike_auth_alg = "sha1"
+    ike_pfs      = "group2"
+    ike_lifetime = 300
+  }
+
+  ipsec_config {
+    ipsec_enc_alg  = "aes"
+    ipsec_auth_alg = "sha1"
+    ipsec_pfs      = "group2"
+    ipsec_lifetime = 180
+  }
+}
+```
+
+Import
+
+VPN Conn can be imported with the VPN gateway id and the VPN conn id, e.g.
+
+```hcl
+$ terraform import baiducloud_vpn_conn.default vpn_gateway_id,vpn_conn_id
+```
+*/
+package baiducloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/services/vpn"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudVpnConn() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudVpnConnCreate,
+		Read:   resourceBaiduCloudVpnConnRead,
+		Update: resourceBaiduCloudVpnConnUpdate,
+		Delete: resourceBaiduCloudVpnConnDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpn_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the VPN gateway.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"vpn_conn_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the VPN conn, consisting of uppercase and lowercase letters、numbers and special characters, such as \"-\",\"_\",\"/\",\".\". The value must start with a letter, and the length should between 1-65.",
+				Required:    true,
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Description: "Shared key of the VPN conn, 8 to 17 characters consisting of letters, numbers and special characters.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"local_subnets": {
+				Type:        schema.TypeList,
+				Description: "Local subnets of the VPN conn in CIDR format.",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.CIDRNetwork(0, 32),
+				},
+			},
+			"remote_ip": {
+				Type:         schema.TypeString,
+				Description:  "Public IP of the remote (customer) gateway.",
+				Required:     true,
+				ValidateFunc: validation.SingleIP(),
+			},
+			"remote_subnets": {
+				Type:        schema.TypeList,
+				Description: "Subnets behind the remote (customer) gateway in CIDR format.",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.CIDRNetwork(0, 32),
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the VPN conn.",
+				Optional:    true,
+			},
+			"ike_config": {
+				Type:        schema.TypeList,
+				Description: "IKE config of the VPN conn.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ike_version": {
+							Type:         schema.TypeString,
+							Description:  "Version of the IKE protocol, valid values are v1 and v2.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"v1", "v2"}, false),
+						},
+						"ike_mode": {
+							Type:         schema.TypeString,
+							Description:  "Negotiation mode of the IKE, valid values are main and aggressive.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"main", "aggressive"}, false),
+						},
+						"ike_enc_alg": {
+							Type:         schema.TypeString,
+							Description:  "Encryption algorithm of the IKE, valid values are aes, aes192, aes256 and 3des.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"aes", "aes192", "aes256", "3des"}, false),
+						},
+						"ike_auth_alg": {
+							Type:         schema.TypeString,
+							Description:  "Authentication algorithm of the IKE, valid values are sha1, md5, sha2_256, sha2_384 and sha2_512.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"sha1", "md5", "sha2_256", "sha2_384", "sha2_512"}, false),
+						},
+						"ike_pfs": {
+							Type:         schema.TypeString,
+							Description:  "Diffie-Hellman group of the IKE, valid values are group2, group5, group14 and group24.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"group2", "group5", "group14", "group24"}, false),
+						},
+						"ike_lifetime": {
+							Type:         schema.TypeInt,
+							Description:  "SA lifetime of the IKE in seconds, support between 60 and 86400.",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(60, 86400),
+						},
+					},
+				},
+			},
+			"ipsec_config": {
+				Type:        schema.TypeList,
+				Description: "IPSec config of the VPN conn.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ipsec_enc_alg": {
+							Type:         schema.TypeString,
+							Description:  "Encryption algorithm of the IPSec, valid values are aes, aes192, aes256 and 3des.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"aes", "aes192", "aes256", "3des"}, false),
+						},
+						"ipsec_auth_alg": {
+							Type:         schema.TypeString,
+							Description:  "Authentication algorithm of the IPSec, valid values are sha1, md5, sha2_256, sha2_384 and sha2_512.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"sha1", "md5", "sha2_256", "sha2_384", "sha2_512"}, false),
+						},
+						"ipsec_pfs": {
+							Type:         schema.TypeString,
+							Description:  "Perfect forward secrecy group of the IPSec, valid values are group2, group5, group14, group24 and disabled.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"group2", "group5", "group14", "group24", "disabled"}, false),
+						},
+						"ipsec_lifetime": {
+							Type:         schema.TypeInt,
+							Description:  "SA lifetime of the IPSec in seconds, support between 180 and 86400.",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(180, 86400),
+						},
+					},
+				},
+			},
+			"local_ip": {
+				Type:        schema.TypeString,
+				Description: "Public IP of the VPN gateway, which is the EIP bound to it.",
+				Computed:    true,
+			},
+			"vpn_conn_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the VPN conn.",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Status of the VPN conn.",
+				Computed:    true,
+			},
+			"health_status": {
+				Type:        schema.TypeString,
+				Description: "Health status of the VPN conn, which reflects whether the IPSec tunnel is established.",
+				Computed:    true,
+			},
+			"created_time": {
+				Type:        schema.TypeString,
+				Description: "Create time of the VPN conn.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudVpnConnCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	vpnService := VpnService{client}
+
+	vpnId := d.Get("vpn_id").(string)
+	action := "Create VPN Conn for VPN Gateway " + vpnId
+
+	raw, _, err := vpnService.VpnGatewayStateRefresh(vpnId)()
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_conn", action, BCESDKGoERROR)
+	}
+	gateway, ok := raw.(*vpn.VPN)
+	if !ok {
+		return WrapErrorf(fmt.Errorf("VPN Gateway %s not found", vpnId), DefaultErrorMsg, "baiducloud_vpn_conn", action, BCESDKGoERROR)
+	}
+
+	args := buildBaiduCloudVpnConnArgs(d, gateway.Eip)
+	if err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		raw, err := client.WithVpnClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
+			return vpnClient.CreateVpnConn(args)
+		})
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(action, raw)
+		result, _ := raw.(*vpn.CreateVpnConnResult)
+		d.SetId(strings.Join([]string{vpnId, result.VpnConnId}, COLON_SEPARATED))
+		return nil
+	}); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_conn", action, BCESDKGoERROR)
+	}
+
+	if err := waitVpnConnAvailable(d, vpnService, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_conn", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudVpnConnRead(d, meta)
+}
+
+func resourceBaiduCloudVpnConnRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	vpnService := VpnService{client}
+
+	vpnId, vpnConnId, err := parseVpnConnId(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	action := "Query VPN Conn " + vpnConnId
+
+	raw, state, err := vpnService.VpnConnStateRefresh(vpnId, vpnConnId)()
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_conn", action, BCESDKGoERROR)
+	}
+	if state == VPN_CONN_STATUS_DELETED {
+		d.SetId("")
+		return nil
+	}
+
+	conn := raw.(*vpn.VpnConn)
+	d.Set("vpn_id", conn.VpnId)
+	d.Set("vpn_conn_id", conn.VpnConnId)
+	d.Set("vpn_conn_name", conn.VpnConnName)
+	d.Set("local_ip", conn.LocalIp)
+	d.Set("local_subnets", conn.LocalSubnets)
+	d.Set("remote_ip", conn.RemoteIp)
+	d.Set("remote_subnets", conn.RemoteSubnets)
+	d.Set("description", conn.Description)
+	d.Set("status", conn.Status)
+	d.Set("health_status", conn.HealthStatus)
+	d.Set("created_time", conn.CreatedTime)
+	// the secret key is returned masked by the openapi, keep the configured one
+	if _, ok := d.GetOk("secret_key"); !ok {
+		d.Set("secret_key", conn.SecretKey)
+	}
+
+	ikeConfig, err := flattenVpnConnIkeConfig(conn.IkeConfig)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("ike_config", ikeConfig)
+
+	ipsecConfig, err := flattenVpnConnIpsecConfig(conn.IpsecConfig)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("ipsec_config", ipsecConfig)
+
+	return nil
+}
+
+func resourceBaiduCloudVpnConnUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	vpnService := VpnService{client}
+
+	vpnId, vpnConnId, err := parseVpnConnId(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	action := "Update VPN Conn " + vpnConnId
+
+	// the update api requires the full config of the VPN conn
+	args := buildBaiduCloudVpnConnArgs(d, d.Get("local_ip").(string))
+	args.VpnId = vpnId
+	if err := vpnService.UpdateVpnConn(vpnConnId, args); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_conn", action, BCESDKGoERROR)
+	}
+
+	if err := waitVpnConnAvailable(d, vpnService, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_conn", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudVpnConnRead(d, meta)
+}
+
+func resourceBaiduCloudVpnConnDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	vpnService := VpnService{client}
+
+	vpnId, vpnConnId, err := parseVpnConnId(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	action := "Delete VPN Conn " + vpnConnId
+
+	clientToken := buildClientToken()
+	_, err = client.WithVpnClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
+		return nil, vpnClient.DeleteVpnConn(vpnConnId, clientToken)
+	})
+	addDebug(action, nil)
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_conn", action, BCESDKGoERROR)
+	}
+
+	stateConf := buildStateConf(
+		[]string{VPN_CONN_STATUS_ACTIVE, VPN_CONN_STATUS_BUILDING, VPN_CONN_STATUS_UPDATING},
+		[]string{VPN_CONN_STATUS_DELETED},
+		d.Timeout(schema.TimeoutDelete),
+		vpnService.VpnConnStateRefresh(vpnId, vpnConnId),
+	)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_conn", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func buildBaiduCloudVpnConnArgs(d *schema.ResourceData, localIp string) *vpn.CreateVpnConnArgs {
+	args := &vpn.CreateVpnConnArgs{
+		ClientToken:   buildClientToken(),
+		VpnId:         d.Get("vpn_id").(string),
+		VpnConnName:   d.Get("vpn_conn_name").(string),
+		LocalIp:       localIp,
+		SecretKey:     d.Get("secret_key").(string),
+		LocalSubnets:  make([]string, 0),
+		RemoteIp:      d.Get("remote_ip").(string),
+		RemoteSubnets: make([]string, 0),
+		Description:   d.Get("description").(string),
+	}
+	for _, subnet := range d.Get("local_subnets").([]interface{}) {
+		args.LocalSubnets = append(args.LocalSubnets, subnet.(string))
+	}
+	for _, subnet := range d.Get("remote_subnets").([]interface{}) {
+		args.RemoteSubnets = append(args.RemoteSubnets, subnet.(string))
+	}
+
+	if v, ok := d.GetOk("ike_config.0"); ok {
+		ikeConfig := v.(map[string]interface{})
+		args.CreateIkeConfig = &vpn.CreateIkeConfig{
+			IkeVersion:  ikeConfig["ike_version"].(string),
+			IkeMode:     ikeConfig["ike_mode"].(string),
+			IkeEncAlg:   ikeConfig["ike_enc_alg"].(string),
+			IkeAuthAlg:  ikeConfig["ike_auth_alg"].(string),
+			IkePfs:      ikeConfig["ike_pfs"].(string),
+			IkeLifeTime: ikeConfig["ike_lifetime"].(int),
+		}
+	}
+	if v, ok := d.GetOk("ipsec_config.0"); ok {
+		ipsecConfig := v.(map[string]interface{})
+		args.CreateIpsecConfig = &vpn.CreateIpsecConfig{
+			IpsecEncAlg:   ipsecConfig["ipsec_enc_alg"].(string),
+			IpsecAuthAlg:  ipsecConfig["ipsec_auth_alg"].(string),
+			IpsecPfs:      ipsecConfig["ipsec_pfs"].(string),
+			IpsecLifetime: ipsecConfig["ipsec_lifetime"].(int),
+		}
+	}
+
+	return args
+}
+
+func flattenVpnConnIkeConfig(config vpn.IkeConfig) ([]map[string]interface{}, error) {
+	lifetime, err := parseVpnConnLifetime(config.IkeLifeTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return []map[string]interface{}{{
+		"ike_version":  config.IkeVersion,
+		"ike_mode":     config.IkeMode,
+		"ike_enc_alg":  config.IkeEncAlg,
+		"ike_auth_alg": config.IkeAuthAlg,
+		"ike_pfs":      config.IkePfs,
+		"ike_lifetime": lifetime,
+	}}, nil
+}
+
+func flattenVpnConnIpsecConfig(config vpn.IpsecConfig) ([]map[string]interface{}, error) {
+	lifetime, err := parseVpnConnLifetime(config.IpsecLifetime)
+	if err != nil {
+		return nil, err
+	}
+
+	return []map[string]interface{}{{
+		"ipsec_enc_alg":  config.IpsecEncAlg,
+		"ipsec_auth_alg": config.IpsecAuthAlg,
+		"ipsec_pfs":      config.IpsecPfs,
+		"ipsec_lifetime": lifetime,
+	}}, nil
+}
+
+// parseVpnConnLifetime parses the lifetime returned by the openapi, such as "300s" or "300"
+func parseVpnConnLifetime(lifetime string) (int, error) {
+	lifetime = strings.TrimSuffix(lifetime, "s")
+	if lifetime == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(lifetime)
+}
+
+func parseVpnConnId(id string) (string, string, error) {
+	parts := strings.Split(id, COLON_SEPARATED)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid VPN conn id %s, should be vpn_id%svpn_conn_id", id, COLON_SEPARATED)
+	}
+	return parts[0], parts[1], nil
+}
+
+func waitVpnConnAvailable(d *schema.ResourceData, vpnService VpnService, timeout time.Duration) error {
+	vpnId, vpnConnId, err := parseVpnConnId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	stateConf := buildStateConf(
+		[]string{VPN_CONN_STATUS_BUILDING, VPN_CONN_STATUS_UPDATING},
+		[]string{VPN_CONN_STATUS_ACTIVE},
+		timeout,
+		vpnService.VpnConnStateRefresh(vpnId, vpnConnId),
+	)
+	_, err = stateConf.WaitForState()
+	return err
+}
@@ -0,0 +1,152 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	testAccVpnConnResourceType     = "baiducloud_vpn_conn"
+	testAccVpnConnResourceName     = testAccVpnConnResourceType + "." + BaiduCloudTestResourceName
+	testAccVpnConnResourceAttrName = BaiduCloudTestResourceAttrNamePrefix + "VpnConn"
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudVpnConn(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccVpnConnDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpnConnConfig(testAccVpnConnResourceAttrName, "192.168.100.0/24", 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccVpnConnResourceName),
+					resource.TestCheckResourceAttr(testAccVpnConnResourceName, "vpn_conn_name", testAccVpnConnResourceAttrName),
+					resource.TestCheckResourceAttr(testAccVpnConnResourceName, "remote_ip", "11.11.11.133"),
+					resource.TestCheckResourceAttr(testAccVpnConnResourceName, "remote_subnets.#", "1"),
+					resource.TestCheckResourceAttr(testAccVpnConnResourceName, "remote_subnets.0", "192.168.100.0/24"),
+					resource.TestCheckResourceAttr(testAccVpnConnResourceName, "ike_config.0.ike_lifetime", "300"),
+					resource.TestCheckResourceAttr(testAccVpnConnResourceName, "ipsec_config.0.ipsec_pfs", "group2"),
+					resource.TestCheckResourceAttrPair(testAccVpnConnResourceName, "local_ip", "baiducloud_eip.default", "eip"),
+					resource.TestCheckResourceAttr(testAccVpnConnResourceName, "status", VPN_CONN_STATUS_ACTIVE),
+					resource.TestCheckResourceAttrSet(testAccVpnConnResourceName, "vpn_conn_id"),
+				),
+			},
+			{
+				ResourceName:            testAccVpnConnResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_key"},
+			},
+			{
+				Config: testAccVpnConnConfig(testAccVpnConnResourceAttrName+"-update", "192.168.200.0/24", 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccVpnConnResourceName),
+					resource.TestCheckResourceAttr(testAccVpnConnResourceName, "vpn_conn_name", testAccVpnConnResourceAttrName+"-update"),
+					resource.TestCheckResourceAttr(testAccVpnConnResourceName, "remote_subnets.0", "192.168.200.0/24"),
+					resource.TestCheckResourceAttr(testAccVpnConnResourceName, "ike_config.0.ike_lifetime", "600"),
+					resource.TestCheckResourceAttr(testAccVpnConnResourceName, "status", VPN_CONN_STATUS_ACTIVE),
+				),
+			},
+		},
+	})
+}
+
+func testAccVpnConnDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.BaiduClient)
+	vpnService := &VpnService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccVpnConnResourceType {
+			continue
+		}
+
+		vpnId, vpnConnId, err := parseVpnConnId(rs.Primary.ID)
+		if err != nil {
+			return WrapError(err)
+		}
+		_, state, err := vpnService.VpnConnStateRefresh(vpnId, vpnConnId)()
+		if err != nil {
+			return WrapError(err)
+		}
+		if state != VPN_CONN_STATUS_DELETED {
+			return WrapError(Error("VpnConn still exist"))
+		}
+	}
+
+	return nil
+}
+
+func testAccVpnConnConfig(name, remoteSubnet string, ikeLifetime int) string {
+	return fmt.Sprintf(`
+resource "baiducloud_vpc" "default" {
+  name = "%s"
+  cidr = "192.168.0.0/16"
+}
+
+data "baiducloud_zones" "default" {}
+
+resource "baiducloud_subnet" "default" {
+  name      = "%s"
+  zone_name = data.baiducloud_zones.default.zones.0.zone_name
+  cidr      = "192.168.1.0/24"
+  vpc_id    = baiducloud_vpc.default.id
+}
+
+resource "baiducloud_eip" "default" {
+  name              = "%s"
+  bandwidth_in_mbps = 1
+  payment_timing    = "Postpaid"
+  billing_method    = "ByTraffic"
+}
+
+resource "baiducloud_vpn_gateway" "default" {
+  vpn_name = "%s"
+  vpc_id   = baiducloud_vpc.default.id
+  eip      = baiducloud_eip.default.eip
+  billing = {
+    payment_timing = "Postpaid"
+  }
+  depends_on = [baiducloud_subnet.default]
+}
+
+resource "baiducloud_vpn_conn" "default" {
+  vpn_id         = baiducloud_vpn_gateway.default.id
+  vpn_conn_name  = "%s"
+  secret_key     = "ddd22@www"
+  local_subnets  = [baiducloud_subnet.default.cidr]
+  remote_ip      = "11.11.11.133"
+  remote_subnets = ["%s"]
+  description    = "created by terraform"
+
+  ike_config {
+    ike_version  = "v1"
+    ike_mode     = "main"
+    ike_enc_alg  = "aes"
+    ike_auth_alg = "sha1"
+    ike_pfs      = "group2"
+    ike_lifetime = %d
+  }
+
+  ipsec_config {
+    ipsec_enc_alg  = "aes"
+    ipsec_auth_alg = "sha1"
+    ipsec_pfs      = "group2"
+    ipsec_lifetime = 180
+  }
+}
+`, BaiduCloudTestResourceAttrNamePrefix+"VPC",
+		BaiduCloudTestResourceAttrNamePrefix+"Subnet",
+		BaiduCloudTestResourceAttrNamePrefix+"EIP",
+		testAccVpnGatewayResourceAttrName,
+		name, remoteSubnet, ikeLifetime)
+}
@@ -0,0 +1,439 @@
+/*
+Provide a resource to create a VPN Gateway.
+
+Example Usage
+
+```hcl
+resource "baiducloud_vpn_gateway" "default" {
+  vpn_name    = "terraform-vpn-gateway"
+  vpc_id      = "vpc-ggm7drdgyvha"
+  description = "office vpn"
+  eip         = "180.76.xx.xx"
+  billing = {
+    payment_timing = "Postpaid"
+  }
+}
+```
+
+Import
+
+VPN Gateway instance can be imported, e.g.
+
+```hcl
+$ terraform import baiducloud_vpn_gateway.default vpn_gateway_id
+```
+*/
+package baiducloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/services/vpn"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudVpnGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudVpnGatewayCreate,
+		Read:   resourceBaiduCloudVpnGatewayRead,
+		Update: resourceBaiduCloudVpnGatewayUpdate,
+		Delete: resourceBaiduCloudVpnGatewayDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpn_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the VPN gateway, consisting of uppercase and lowercase letters、numbers and special characters, such as \"-\",\"_\",\"/\",\".\". The value must start with a letter, and the length should between 1-65.",
+				Required:    true,
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Description: "VPC ID of the VPN gateway.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the VPN gateway.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"eip": {
+				Type:        schema.TypeString,
+				Description: "EIP associated with the VPN gateway. Changing it binds or unbinds the EIP in place.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Status of the VPN gateway.",
+				Computed:    true,
+			},
+			"expired_time": {
+				Type:        schema.TypeString,
+				Description: "Expired time of the VPN gateway, which will be empty when the payment_timing is Postpaid.",
+				Computed:    true,
+			},
+			"bandwidth_in_mbps": {
+				Type:        schema.TypeInt,
+				Description: "Bandwidth of the EIP associated with the VPN gateway in Mbps.",
+				Computed:    true,
+			},
+			"vpn_conns": {
+				Type:        schema.TypeList,
+				Description: "IDs of the VPN conns of the VPN gateway.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"renew_length": {
+				Type:         schema.TypeInt,
+				Description:  "Renewal length of the VPN gateway, which is only valid when the payment_timing is Prepaid. It is ignored on creation, and every change renews the VPN gateway for the new length in place. Valid values: [1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36].",
+				Optional:     true,
+				ValidateFunc: validateReservationLength(),
+			},
+			"renew_time_unit": {
+				Type:         schema.TypeString,
+				Description:  "Renewal time unit of the VPN gateway, which is only valid when the payment_timing is Prepaid. The value can only be month currently, which is also the default value.",
+				Optional:     true,
+				ValidateFunc: validateReservationUnit(),
+			},
+			"billing": {
+				Type:        schema.TypeMap,
+				Description: "Billing information of the VPN gateway.",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"payment_timing": {
+							Type:         schema.TypeString,
+							Description:  "Payment timing of the billing, which can be Prepaid or Postpaid. The default is Postpaid.",
+							Required:     true,
+							ForceNew:     true,
+							Default:      PAYMENT_TIMING_POSTPAID,
+							ValidateFunc: validatePaymentTiming(),
+						},
+						"reservation": {
+							Type:             schema.TypeMap,
+							Description:      "Reservation of the VPN gateway.",
+							Optional:         true,
+							DiffSuppressFunc: postPaidDiffSuppressFunc,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"reservation_length": {
+										Type:             schema.TypeInt,
+										Description:      "Reservation length that you will pay for your resource. It is valid when payment_timing is Prepaid. Valid values: [1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36].",
+										Optional:         true,
+										Default:          1,
+										ForceNew:         true,
+										DiffSuppressFunc: postPaidDiffSuppressFunc,
+										ValidateFunc:     validateReservationLength(),
+									},
+									"reservation_time_unit": {
+										Type:             schema.TypeString,
+										Description:      "Reservation time unit that you will pay for your resource. It is valid when payment_timing is Prepaid. The value can only be month currently, which is also the default value.",
+										Optional:         true,
+										Default:          "month",
+										ValidateFunc:     validateReservationUnit(),
+										DiffSuppressFunc: postPaidDiffSuppressFunc,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudVpnGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	vpnService := VpnService{client}
+
+	args := buildBaiduCloudVpnGatewayArgs(d)
+	action := "Create VPN Gateway " + args.VpnName
+
+	if err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		raw, err := client.WithVpnClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
+			return vpnClient.CreateVpnGateway(args)
+		})
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(action, raw)
+		result, _ := raw.(*vpn.CreateVpnGatewayResult)
+		d.SetId(result.VpnId)
+		return nil
+	}); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_gateway", action, BCESDKGoERROR)
+	}
+
+	if err := waitVpnGatewayConfigured(d, vpnService, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_gateway", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudVpnGatewayRead(d, meta)
+}
+
+func resourceBaiduCloudVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	vpnService := VpnService{client}
+
+	vpnId := d.Id()
+	action := "Query VPN Gateway " + vpnId
+
+	result, state, err := vpnService.VpnGatewayStateRefresh(vpnId)()
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_gateway", action, BCESDKGoERROR)
+	}
+	if state == VPN_STATUS_DELETED {
+		d.SetId("")
+		return nil
+	}
+
+	gateway := result.(*vpn.VPN)
+	d.Set("vpn_name", gateway.Name)
+	d.Set("vpc_id", gateway.VpcId)
+	d.Set("description", gateway.Description)
+	d.Set("eip", gateway.Eip)
+	d.Set("bandwidth_in_mbps", gateway.BandwidthInMbps)
+
+	vpnConns := make([]string, 0, len(gateway.VpnConns))
+	for _, conn := range gateway.VpnConns {
+		vpnConns = append(vpnConns, conn.VpnConnId)
+	}
+	d.Set("vpn_conns", vpnConns)
+
+	billingMap := map[string]interface{}{"payment_timing": gateway.ProductType}
+	d.Set("billing", billingMap)
+
+	d.Set("expired_time", gateway.ExpiredTime)
+	d.Set("status", string(gateway.Status))
+
+	return nil
+}
+
+func resourceBaiduCloudVpnGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	vpnService := VpnService{client}
+
+	vpnId := d.Id()
+	action := "Update VPN Gateway " + vpnId
+
+	d.Partial(true)
+	if d.HasChange("vpn_name") {
+		args := &vpn.UpdateVpnGatewayArgs{
+			ClientToken: buildClientToken(),
+			Name:        d.Get("vpn_name").(string),
+		}
+
+		_, err := client.WithVpnClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
+			return nil, vpnClient.UpdateVpnGateway(vpnId, args)
+		})
+		addDebug(action, args)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_gateway", action, BCESDKGoERROR)
+		}
+		d.SetPartial("vpn_name")
+	}
+
+	if d.HasChange("eip") {
+		o, n := d.GetChange("eip")
+
+		if o.(string) != "" {
+			if err := unbindVpnGatewayEip(meta, o.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_gateway", action, BCESDKGoERROR)
+			}
+		}
+		if n.(string) != "" {
+			if err := bindVpnGatewayEip(meta, n.(string), vpnId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_gateway", action, BCESDKGoERROR)
+			}
+		}
+
+		if err := waitVpnGatewayConfigured(d, vpnService, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_gateway", action, BCESDKGoERROR)
+		}
+		d.SetPartial("eip")
+	}
+
+	if d.HasChange("renew_length") {
+		renewLength := d.Get("renew_length").(int)
+		if renewLength > 0 {
+			paymentTiming := d.Get("billing").(map[string]interface{})["payment_timing"]
+			if paymentTiming != PAYMENT_TIMING_PREPAID {
+				return WrapErrorf(fmt.Errorf("Only Prepaid VPN gateway can be renewed."), DefaultErrorMsg, "baiducloud_vpn_gateway", action, BCESDKGoERROR)
+			}
+
+			args := &vpn.RenewVpnGatewayArgs{
+				ClientToken: buildClientToken(),
+				Billing: &vpn.Billing{
+					Reservation: &vpn.Reservation{
+						ReservationLength:   renewLength,
+						ReservationTimeUnit: "month",
+					},
+				},
+			}
+			if v := d.Get("renew_time_unit").(string); v != "" {
+				args.Billing.Reservation.ReservationTimeUnit = v
+			}
+			_, err := client.WithVpnClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
+				return nil, vpnClient.RenewVpnGateway(vpnId, args)
+			})
+			addDebug(action, args)
+			if err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_gateway", action, BCESDKGoERROR)
+			}
+		}
+
+		d.SetPartial("renew_length")
+	}
+
+	d.Partial(false)
+
+	return resourceBaiduCloudVpnGatewayRead(d, meta)
+}
+
+func resourceBaiduCloudVpnGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	vpnService := VpnService{client}
+
+	vpnId := d.Id()
+	action := "Delete VPN Gateway " + vpnId
+
+	clientToken := buildClientToken()
+	_, err := client.WithVpnClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
+		return nil, vpnClient.DeleteVpnGateway(vpnId, clientToken)
+	})
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_gateway", action, BCESDKGoERROR)
+	}
+	addDebug(action, nil)
+
+	stateConf := buildStateConf(
+		[]string{string(vpn.VPN_STATUS_ACTIVE), string(vpn.VPN_STATUS_UNCONFIGURED), string(vpn.VPN_STATUS_CONFIGURING)},
+		[]string{VPN_STATUS_DELETED},
+		d.Timeout(schema.TimeoutDelete),
+		vpnService.VpnGatewayStateRefresh(vpnId),
+	)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_gateway", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func buildBaiduCloudVpnGatewayArgs(d *schema.ResourceData) *vpn.CreateVpnGatewayArgs {
+	args := &vpn.CreateVpnGatewayArgs{
+		ClientToken: buildClientToken(),
+		VpnName:     d.Get("vpn_name").(string),
+		VpcId:       d.Get("vpc_id").(string),
+		Description: d.Get("description").(string),
+		Billing:     &vpn.Billing{},
+	}
+	if v, ok := d.GetOk("eip"); ok {
+		args.Eip = v.(string)
+	}
+
+	if v, ok := d.GetOk("billing"); ok {
+		billing := v.(map[string]interface{})
+		if p, ok := billing["payment_timing"]; ok {
+			args.Billing.PaymentTiming = vpn.PaymentTimingType(p.(string))
+		}
+		if args.Billing.PaymentTiming == PAYMENT_TIMING_PREPAID {
+			if r, ok := billing["reservation"]; ok {
+				args.Billing.Reservation = &vpn.Reservation{}
+				reservation := r.(map[string]interface{})
+				if reservationLength, ok := reservation["reservation_length"]; ok {
+					args.Billing.Reservation.ReservationLength = reservationLength.(int)
+				}
+				if reservationTimeUnit, ok := reservation["reservation_time_unit"]; ok {
+					args.Billing.Reservation.ReservationTimeUnit = reservationTimeUnit.(string)
+				}
+			}
+		}
+	}
+
+	return args
+}
+
+func bindVpnGatewayEip(meta interface{}, eipAddress, vpnId string, timeout time.Duration) error {
+	client := meta.(*connectivity.BaiduClient)
+	eipService := EipService{client}
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		err := eipService.EipBind(eipAddress, InstanceTypeVPN, vpnId)
+		addDebug("Bind EIP "+eipAddress+" with VPN Gateway "+vpnId, err)
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	stateConf := buildStateConf(EIPProcessingStatus,
+		[]string{EIPStatusBinded},
+		timeout,
+		eipService.EipStateRefreshFunc(eipAddress, append(EIPFailedStatus, EIPStatusAvailable)))
+	_, err = stateConf.WaitForState()
+
+	return err
+}
+
+func unbindVpnGatewayEip(meta interface{}, eipAddress string, timeout time.Duration) error {
+	client := meta.(*connectivity.BaiduClient)
+	eipService := EipService{client}
+
+	if err := eipService.EipUnBind(eipAddress); err != nil {
+		return err
+	}
+
+	stateConf := buildStateConf(EIPProcessingStatus,
+		[]string{EIPStatusAvailable},
+		timeout,
+		eipService.EipStateRefreshFunc(eipAddress, EIPFailedStatus))
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+// waitVpnGatewayConfigured waits for the VPN gateway to finish building or (re)binding the EIP.
+// A VPN gateway without any VPN conn stays unconfigured, so both states are accepted.
+func waitVpnGatewayConfigured(d *schema.ResourceData, vpnService VpnService, timeout time.Duration) error {
+	stateConf := buildStateConf(
+		[]string{string(vpn.VPN_STATUS_BUILDING), string(vpn.VPN_STATUS_CONFIGURING)},
+		[]string{string(vpn.VPN_STATUS_ACTIVE), string(vpn.VPN_STATUS_UNCONFIGURED)},
+		timeout,
+		vpnService.VpnGatewayStateRefresh(d.Id()),
+	)
+	_, err := stateConf.WaitForState()
+	return err
+}
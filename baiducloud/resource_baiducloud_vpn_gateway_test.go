@@ -0,0 +1,124 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	testAccVpnGatewayResourceType     = "baiducloud_vpn_gateway"
+	testAccVpnGatewayResourceName     = testAccVpnGatewayResourceType + "." + BaiduCloudTestResourceName
+	testAccVpnGatewayResourceAttrName = BaiduCloudTestResourceAttrNamePrefix + "VpnGateway"
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudVpnGateway(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccVpnGatewayDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpnGatewayConfig(testAccVpnGatewayResourceAttrName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccVpnGatewayResourceName),
+					resource.TestCheckResourceAttr(testAccVpnGatewayResourceName, "vpn_name", testAccVpnGatewayResourceAttrName),
+					resource.TestCheckResourceAttr(testAccVpnGatewayResourceName, "description", "created by terraform"),
+					resource.TestCheckResourceAttr(testAccVpnGatewayResourceName, "eip", ""),
+					resource.TestCheckResourceAttr(testAccVpnGatewayResourceName, "billing.payment_timing", "Postpaid"),
+					resource.TestCheckResourceAttrSet(testAccVpnGatewayResourceName, "vpc_id"),
+					resource.TestCheckResourceAttrSet(testAccVpnGatewayResourceName, "status"),
+				),
+			},
+			{
+				ResourceName:      testAccVpnGatewayResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVpnGatewayConfig(testAccVpnGatewayResourceAttrName+"-update", "eip = baiducloud_eip.default.eip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccVpnGatewayResourceName),
+					resource.TestCheckResourceAttr(testAccVpnGatewayResourceName, "vpn_name", testAccVpnGatewayResourceAttrName+"-update"),
+					resource.TestCheckResourceAttrPair(testAccVpnGatewayResourceName, "eip", "baiducloud_eip.default", "eip"),
+					resource.TestCheckResourceAttr(testAccVpnGatewayResourceName, "bandwidth_in_mbps", "1"),
+				),
+			},
+			{
+				Config: testAccVpnGatewayConfig(testAccVpnGatewayResourceAttrName+"-update", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccVpnGatewayResourceName),
+					resource.TestCheckResourceAttr(testAccVpnGatewayResourceName, "eip", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccVpnGatewayDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.BaiduClient)
+	vpnService := &VpnService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccVpnGatewayResourceType {
+			continue
+		}
+
+		_, state, err := vpnService.VpnGatewayStateRefresh(rs.Primary.ID)()
+		if err != nil {
+			return WrapError(err)
+		}
+		if state != VPN_STATUS_DELETED {
+			return WrapError(Error("VpnGateway still exist"))
+		}
+	}
+
+	return nil
+}
+
+func testAccVpnGatewayConfig(name, eip string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_vpc" "default" {
+  name = "%s"
+  cidr = "192.168.0.0/16"
+}
+
+data "baiducloud_zones" "default" {}
+
+resource "baiducloud_subnet" "default" {
+  name      = "%s"
+  zone_name = data.baiducloud_zones.default.zones.0.zone_name
+  cidr      = "192.168.1.0/24"
+  vpc_id    = baiducloud_vpc.default.id
+}
+
+resource "baiducloud_eip" "default" {
+  name              = "%s"
+  bandwidth_in_mbps = 1
+  payment_timing    = "Postpaid"
+  billing_method    = "ByTraffic"
+}
+
+resource "baiducloud_vpn_gateway" "default" {
+  vpn_name    = "%s"
+  vpc_id      = baiducloud_vpc.default.id
+  description = "created by terraform"
+  %s
+  billing = {
+    payment_timing = "Postpaid"
+  }
+  depends_on = [baiducloud_subnet.default]
+}
+`, BaiduCloudTestResourceAttrNamePrefix+"VPC",
+		BaiduCloudTestResourceAttrNamePrefix+"Subnet",
+		BaiduCloudTestResourceAttrNamePrefix+"EIP",
+		name, eip)
+}
@@ -0,0 +1,173 @@
+package baiducloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/services/appblb"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func (s *APPBLBService) AppIpGroupDetail(blbId, ipGroupId string) (*appblb.AppIpGroup, error) {
+	describeArgs := &appblb.DescribeAppIpGroupArgs{}
+
+	for {
+		raw, err := s.client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+			return client.DescribeAppIpGroup(blbId, describeArgs)
+		})
+		if err != nil {
+			return nil, WrapError(err)
+		}
+
+		result := raw.(*appblb.DescribeAppIpGroupResult)
+		for _, group := range result.AppIpGroupList {
+			if group.Id == ipGroupId {
+				return &group, nil
+			}
+		}
+
+		if result.IsTruncated {
+			describeArgs.Marker = result.NextMarker
+			describeArgs.MaxKeys = result.MaxKeys
+		} else {
+			return nil, WrapError(fmt.Errorf(ResourceNotFound))
+		}
+	}
+}
+
+func (s *APPBLBService) AppIpGroupMembers(blbId, ipGroupId string) ([]appblb.AppIpGroupMember, error) {
+	describeArgs := &appblb.DescribeAppIpGroupMemberArgs{
+		IpGroupId: ipGroupId,
+	}
+
+	result := make([]appblb.AppIpGroupMember, 0)
+	for {
+		raw, err := s.client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+			return client.DescribeAppIpGroupMember(blbId, describeArgs)
+		})
+		if err != nil {
+			return nil, WrapError(err)
+		}
+
+		response := raw.(*appblb.DescribeAppIpGroupMemberResult)
+		result = append(result, response.MemberList...)
+
+		if response.IsTruncated {
+			describeArgs.Marker = response.NextMarker
+			describeArgs.MaxKeys = response.MaxKeys
+		} else {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+func (s *APPBLBService) AppIpGroupMemberDetail(blbId, ipGroupId, memberId string) (*appblb.AppIpGroupMember, error) {
+	members, err := s.AppIpGroupMembers(blbId, ipGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range members {
+		if member.MemberId == memberId {
+			return &member, nil
+		}
+	}
+
+	return nil, WrapError(fmt.Errorf(ResourceNotFound))
+}
+
+func (s *APPBLBService) FlattenAppIpGroupBackendPoliciesToMap(policies []appblb.AppIpGroupBackendPolicy) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(policies))
+
+	for _, p := range policies {
+		result = append(result, map[string]interface{}{
+			"id":                              p.Id,
+			"type":                            p.Type,
+			"health_check_port":               p.HealthCheckPort,
+			"health_check_timeout_in_second":  p.HealthCheckTimeoutInSecond,
+			"health_check_interval_in_second": p.HealthCheckIntervalInSecond,
+			"health_check_down_retry":         p.HealthCheckDownRetry,
+			"health_check_up_retry":           p.HealthCheckUpRetry,
+			"health_check_normal_status":      p.HealthCheckNormalStatus,
+			"health_check_url_path":           p.HealthCheckUrlPath,
+			"udp_health_check_string":         p.UdpHealthCheckString,
+		})
+	}
+
+	return result
+}
+
+func (s *APPBLBService) CreateAppIpGroupBackendPolicy(blbId string, args *appblb.CreateAppIpGroupBackendPolicyArgs, timeout time.Duration) error {
+	action := fmt.Sprintf("Create App Ip Group %s Backend Policy %s", args.IpGroupId, args.Type)
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		raw, err := s.client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+			return nil, client.CreateAppIpGroupBackendPolicy(blbId, args)
+		})
+		addDebug(action, raw)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_ip_group", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func (s *APPBLBService) UpdateAppIpGroupBackendPolicy(blbId string, args *appblb.UpdateAppIpGroupBackendPolicyArgs, timeout time.Duration) error {
+	action := fmt.Sprintf("Update App Ip Group %s Backend Policy %s", args.IpGroupId, args.Id)
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		raw, err := s.client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+			return nil, client.UpdateAppIpGroupBackendPolicy(blbId, args)
+		})
+		addDebug(action, raw)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_ip_group", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func (s *APPBLBService) DeleteAppIpGroupBackendPolicy(blbId string, args *appblb.DeleteAppIpGroupBackendPolicyArgs, timeout time.Duration) error {
+	action := fmt.Sprintf("Delete App Ip Group %s Backend Policy %v", args.IpGroupId, args.BackendPolicyIdList)
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		raw, err := s.client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+			return nil, client.DeleteAppIpGroupBackendPolicy(blbId, args)
+		})
+		addDebug(action, raw)
+
+		if err != nil {
+			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_ip_group", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
@@ -95,6 +95,21 @@ func (s *APPBLBService) DescribePolicys(blbId, protocol string, port int) ([]app
 	}
 }
 
+func (s *APPBLBService) AppPolicyDetail(blbId, protocol string, port, priority int) (*appblb.AppPolicy, error) {
+	policies, err := s.DescribePolicys(blbId, protocol, port)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, policy := range policies {
+		if policy.Priority == priority && (policy.PortType == "" || policy.PortType == protocol) {
+			return &policy, nil
+		}
+	}
+
+	return nil, WrapError(fmt.Errorf(ResourceNotFound))
+}
+
 func (s *APPBLBService) FlattenAppPolicysToMap(policys []appblb.AppPolicy) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, len(policys))
 
@@ -104,6 +119,8 @@ func (s *APPBLBService) FlattenAppPolicysToMap(policys []appblb.AppPolicy) []map
 			"description":           policy.Description,
 			"app_server_group_id":   policy.AppServerGroupId,
 			"app_server_group_name": policy.AppServerGroupName,
+			"app_ip_group_id":       policy.AppIpGroupId,
+			"app_ip_group_name":     policy.AppIpGroupName,
 			"frontend_port":         policy.FrontendPort,
 			"backend_port":          policy.BackendPort,
 			"priority":              policy.Priority,
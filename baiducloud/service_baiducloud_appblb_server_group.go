@@ -3,6 +3,7 @@ package baiducloud
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/appblb"
@@ -126,6 +127,52 @@ func (s *APPBLBService) FlattenAppBackendServersToMap(servers []appblb.AppBacken
 	return result
 }
 
+func (s *APPBLBService) AppServerGroupRsMount(blbId, sgId string) ([]appblb.AppBackendServer, []appblb.AppBackendServer, error) {
+	raw, err := s.client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+		return client.DescribeRsMount(blbId, sgId)
+	})
+	addDebug("Describe APPBLB "+blbId+" App Server Group "+sgId+" Mount Rs", raw)
+	if err != nil {
+		return nil, nil, WrapError(err)
+	}
+	mounted := raw.(*appblb.DescribeRsMountResult).BackendServerList
+
+	raw, err = s.client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+		return client.DescribeRsUnMount(blbId, sgId)
+	})
+	addDebug("Describe APPBLB "+blbId+" App Server Group "+sgId+" UnMount Rs", raw)
+	if err != nil {
+		return nil, nil, WrapError(err)
+	}
+	unmounted := raw.(*appblb.DescribeRsMountResult).BackendServerList
+
+	return mounted, unmounted, nil
+}
+
+func (s *APPBLBService) FlattenAppBackendServersHealthToMap(servers []appblb.AppBackendServer) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(servers))
+
+	for _, server := range servers {
+		healthy := len(server.PortList) > 0
+		for _, port := range server.PortList {
+			if port.Status != AppRsPortStatusAlive {
+				healthy = false
+				break
+			}
+		}
+
+		result = append(result, map[string]interface{}{
+			"instance_id": server.InstanceId,
+			"weight":      server.Weight,
+			"private_ip":  server.PrivateIp,
+			"healthy":     healthy,
+			"port_list":   s.FlattenAppRsPortsToMap(server.PortList),
+		})
+	}
+
+	return result
+}
+
 func (s *APPBLBService) FlattenAppRsPortsToMap(ports []appblb.AppRsPortModel) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, len(ports))
 
@@ -274,11 +321,15 @@ func (s *APPBLBService) DeleteAppServerGroupRs(blbId string, args *appblb.Delete
 }
 
 func (s *APPBLBService) WaitForServerGroupUpdateFinish(d *schema.ResourceData) error {
+	return s.WaitForServerGroupAvailable(d.Get("blb_id").(string), d.Id(), d.Timeout(schema.TimeoutCreate))
+}
+
+func (s *APPBLBService) WaitForServerGroupAvailable(blbId, sgId string, timeout time.Duration) error {
 	stateConf := buildStateConf(
 		APPBLBProcessingStatus,
 		APPBLBAvailableStatus,
-		d.Timeout(schema.TimeoutCreate),
-		s.AppServerGroupStateRefreshFunc(d.Get("blb_id").(string), d.Id(), APPBLBFailedStatus))
+		timeout,
+		s.AppServerGroupStateRefreshFunc(blbId, sgId, APPBLBFailedStatus))
 	if _, err := stateConf.WaitForState(); err != nil {
 		return WrapError(err)
 	}
@@ -286,6 +337,21 @@ func (s *APPBLBService) WaitForServerGroupUpdateFinish(d *schema.ResourceData) e
 	return nil
 }
 
+func (s *APPBLBService) AppServerGroupRsDetail(blbId, sgId, instanceId string) (*appblb.AppBackendServer, error) {
+	servers, err := s.AppServerGroupBlbRsDetail(blbId, sgId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, server := range servers {
+		if server.InstanceId == instanceId {
+			return &server, nil
+		}
+	}
+
+	return nil, WrapError(fmt.Errorf(ResourceNotFound))
+}
+
 func (s *APPBLBService) ListAllServerGroups(blbId string, args *appblb.DescribeAppServerGroupArgs) ([]map[string]interface{}, error) {
 	serverGroupList := make([]appblb.AppServerGroup, 0)
 	for {
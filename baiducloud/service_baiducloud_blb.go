@@ -0,0 +1,233 @@
+package baiducloud
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/baidubce/bce-sdk-go/services/blb"
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+type BLBService struct {
+	client *connectivity.BaiduClient
+}
+
+func (s *BLBService) BLBStateRefreshFunc(id string, failState []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		raw, err := s.client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+			return client.DescribeLoadBalancerDetail(id)
+		})
+		if err != nil {
+			return nil, "", WrapError(err)
+		}
+
+		result := raw.(*blb.DescribeLoadBalancerDetailResult)
+		for _, statue := range failState {
+			if string(result.Status) == statue {
+				return result, string(result.Status), WrapError(Error(GetFailTargetStatus, result.Status))
+			}
+		}
+
+		return result, string(result.Status), nil
+	}
+}
+
+func (s *BLBService) GetBLBDetail(blbId string) (*blb.BLBModel, *blb.DescribeLoadBalancerDetailResult, error) {
+	action := "Describe BLB " + blbId + " Detail"
+
+	raw, err := s.client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+		return client.DescribeLoadBalancerDetail(blbId)
+	})
+	addDebug(action, raw)
+
+	if err != nil {
+		return nil, nil, WrapError(err)
+	}
+	blbDetail := raw.(*blb.DescribeLoadBalancerDetailResult)
+
+	action = "List BLB " + blbId
+	listArgs := &blb.DescribeLoadBalancersArgs{
+		BlbId: blbDetail.BlbId,
+	}
+	raw, err = s.client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+		return client.DescribeLoadBalancers(listArgs)
+	})
+	addDebug(action, raw)
+
+	if err != nil {
+		return nil, nil, WrapError(err)
+	}
+	blbList := raw.(*blb.DescribeLoadBalancersResult).BlbList
+	if len(blbList) == 0 {
+		return nil, nil, WrapError(fmt.Errorf(ResourceNotFound))
+	}
+
+	return &blbList[0], blbDetail, nil
+}
+
+func (s *BLBService) ListAllBLB(args *blb.DescribeLoadBalancersArgs) ([]blb.BLBModel, map[string]blb.DescribeLoadBalancerDetailResult, error) {
+	action := "List all BLB"
+
+	blbModels := make([]blb.BLBModel, 0)
+	for {
+		raw, err := s.client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+			return client.DescribeLoadBalancers(args)
+		})
+
+		if err != nil {
+			return nil, nil, WrapError(err)
+		}
+		addDebug(action, raw)
+
+		response := raw.(*blb.DescribeLoadBalancersResult)
+		blbModels = append(blbModels, response.BlbList...)
+
+		if response.IsTruncated {
+			args.Marker = response.NextMarker
+			args.MaxKeys = response.MaxKeys
+		} else {
+			break
+		}
+	}
+
+	blbDetails := make(map[string]blb.DescribeLoadBalancerDetailResult)
+	for _, model := range blbModels {
+		raw, err := s.client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+			return client.DescribeLoadBalancerDetail(model.BlbId)
+		})
+		if err != nil {
+			return nil, nil, WrapError(err)
+		}
+
+		blbDetails[model.BlbId] = *raw.(*blb.DescribeLoadBalancerDetailResult)
+	}
+
+	return blbModels, blbDetails, nil
+}
+
+func (s *BLBService) FlattenBLBListenerModelToMap(listeners []blb.ListenerModel) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(listeners))
+	for _, l := range listeners {
+		port, _ := strconv.Atoi(l.Port)
+		result = append(result, map[string]interface{}{
+			"port": port,
+			"type": l.Type,
+		})
+	}
+
+	return result
+}
+
+func (s *BLBService) FlattenBLBDetailsToMap(models []blb.BLBModel, details map[string]blb.DescribeLoadBalancerDetailResult) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(models))
+	for _, model := range models {
+		detail := details[model.BlbId]
+
+		result = append(result, map[string]interface{}{
+			"blb_id":      model.BlbId,
+			"name":        model.Name,
+			"description": model.Description,
+			"address":     model.Address,
+			"status":      model.Status,
+			"vpc_id":      model.VpcId,
+			"vpc_name":    detail.VpcName,
+			"subnet_id":   model.SubnetId,
+			"public_ip":   model.PublicIp,
+			"cidr":        detail.Cidr,
+			"create_time": detail.CreateTime,
+			"listener":    s.FlattenBLBListenerModelToMap(detail.Listener),
+			"tags":        flattenTagsToMap(model.Tags),
+		})
+	}
+
+	return result
+}
+
+func (s *BLBService) DescribeListener(blbId, protocol string, port int) (interface{}, error) {
+	args := &blb.DescribeListenerArgs{
+		ListenerPort: uint16(port),
+	}
+	action := fmt.Sprintf("Describe BLB %s Listener [%s.%d]", blbId, protocol, port)
+
+	raw, err := s.client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+		switch protocol {
+		case TCP:
+			return client.DescribeTCPListeners(blbId, args)
+		case UDP:
+			return client.DescribeUDPListeners(blbId, args)
+		case HTTP:
+			return client.DescribeHTTPListeners(blbId, args)
+		case HTTPS:
+			return client.DescribeHTTPSListeners(blbId, args)
+		case SSL:
+			return client.DescribeSSLListeners(blbId, args)
+		default:
+			return nil, fmt.Errorf("unsupport listener type: %s", protocol)
+		}
+	})
+	addDebug(action, raw)
+
+	if err != nil {
+		return nil, WrapError(err)
+	}
+
+	switch protocol {
+	case TCP:
+		response := raw.(*blb.DescribeTCPListenersResult)
+		if len(response.ListenerList) > 0 {
+			return &response.ListenerList[0], nil
+		}
+	case UDP:
+		response := raw.(*blb.DescribeUDPListenersResult)
+		if len(response.ListenerList) > 0 {
+			return &response.ListenerList[0], nil
+		}
+	case HTTP:
+		response := raw.(*blb.DescribeHTTPListenersResult)
+		if len(response.ListenerList) > 0 {
+			return &response.ListenerList[0], nil
+		}
+	case HTTPS:
+		response := raw.(*blb.DescribeHTTPSListenersResult)
+		if len(response.ListenerList) > 0 {
+			return &response.ListenerList[0], nil
+		}
+	case SSL:
+		response := raw.(*blb.DescribeSSLListenersResult)
+		if len(response.ListenerList) > 0 {
+			return &response.ListenerList[0], nil
+		}
+	default:
+	}
+
+	return nil, WrapError(fmt.Errorf(ResourceNotFound))
+}
+
+func (s *BLBService) BackendServerDetail(blbId, instanceId string) (*blb.BackendServerModel, error) {
+	args := &blb.DescribeBackendServersArgs{}
+
+	for {
+		raw, err := s.client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+			return client.DescribeBackendServers(blbId, args)
+		})
+		if err != nil {
+			return nil, WrapError(err)
+		}
+
+		response := raw.(*blb.DescribeBackendServersResult)
+		for _, server := range response.BackendServerList {
+			if server.InstanceId == instanceId {
+				return &server, nil
+			}
+		}
+
+		if response.IsTruncated {
+			args.Marker = response.NextMarker
+			args.MaxKeys = response.MaxKeys
+		} else {
+			return nil, WrapError(fmt.Errorf(ResourceNotFound))
+		}
+	}
+}
@@ -1,10 +1,21 @@
 package baiducloud
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
 	"reflect"
+	"strings"
+	"sync"
 
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/http"
 	"github.com/baidubce/bce-sdk-go/services/bos"
 	"github.com/baidubce/bce-sdk-go/services/bos/api"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
@@ -21,12 +32,99 @@ const (
 
 	BOS_BUCKET_OBJECT_CONTENT_DISPOSITION_INLINE     = "inline"
 	BOS_BUCKET_OBJECT_CONTENT_DISPOSITION_ATTACHMENT = "attachment"
+
+	BOS_NOTIFICATION_STATUS_ENABLED  = "enabled"
+	BOS_NOTIFICATION_STATUS_DISABLED = "disabled"
+	BOS_NOTIFICATION_CFC_BRN_PREFIX  = "brn:bce:cfc:"
+
+	BOS_BUCKET_DEFAULT_TRASH_DIR = ".trash"
+
+	BOS_REPLICATION_HISTORY_SYNCING  = "syncing"
+	BOS_REPLICATION_HISTORY_FINISHED = "finished"
+
+	BOS_SERVER_SIDE_ENCRYPTION_AES256 = "AES256"
+	BOS_SERVER_SIDE_ENCRYPTION_KMS    = "KMS"
+
+	BOS_HEADER_SERVER_SIDE_ENCRYPTION            = "x-bce-server-side-encryption"
+	BOS_HEADER_SERVER_SIDE_ENCRYPTION_KMS_KEY_ID = "x-bce-server-side-encryption-bos-kms-key-id"
+
+	BOS_CLIENT_SIDE_ENCRYPTION_META_PREFIX   = "client-side-encryption-"
+	BOS_CLIENT_SIDE_ENCRYPTION_ALGORITHM     = "AES/GCM/NoPadding/Chunked"
+	BOS_CLIENT_SIDE_ENCRYPTION_KEY_ALGORITHM = "AES/GCM/NoPadding"
+	BOS_CLIENT_SIDE_ENCRYPTION_CHUNK_SIZE    = 64 << 10
+
+	bosClientSideEncryptionNoncePrefixSize = 7
 )
 
 type BosService struct {
 	client *connectivity.BaiduClient
 }
 
+// bosRequestClient adds the headers not supported by the vendored sdk, such as the server side encryption ones, to
+// the requests sent by the sdk api, and keeps the headers of the last response
+type bosRequestClient struct {
+	bce.Client
+	headers         map[string]string
+	responseHeaders map[string]string
+}
+
+// bosBucketEncryption carries the kms key id which is missing in api.BucketEncryptionType
+type bosBucketEncryption struct {
+	EncryptionAlgorithm string `json:"encryptionAlgorithm"`
+	KmsKeyId            string `json:"kmsKeyId,omitempty"`
+}
+
+func (c *bosRequestClient) SendRequest(req *bce.BceRequest, resp *bce.BceResponse) error {
+	for k, v := range c.headers {
+		req.SetHeader(k, v)
+	}
+	if err := c.Client.SendRequest(req, resp); err != nil {
+		return err
+	}
+	c.responseHeaders = resp.Headers()
+
+	return nil
+}
+
+func (c *bosRequestClient) SendRequestFromBytes(req *bce.BceRequest, resp *bce.BceResponse, content []byte) error {
+	for k, v := range c.headers {
+		req.SetHeader(k, v)
+	}
+	if err := c.Client.SendRequestFromBytes(req, resp, content); err != nil {
+		return err
+	}
+	c.responseHeaders = resp.Headers()
+
+	return nil
+}
+
+// customizeBosKmsKeyIdDiff rejects kms_key_id set along with a server side encryption other than KMS at plan time.
+// kms_key_id is only read back when it is set, so a non-empty one always comes from the config.
+func customizeBosKmsKeyIdDiff(d *schema.ResourceDiff, sseKey string) error {
+	if !d.NewValueKnown(sseKey) || !d.NewValueKnown("kms_key_id") {
+		return nil
+	}
+
+	kmsKeyId := d.Get("kms_key_id").(string)
+	if d.Get(sseKey).(string) == BOS_SERVER_SIDE_ENCRYPTION_KMS || kmsKeyId == "" {
+		return nil
+	}
+
+	return fmt.Errorf("kms_key_id %s is only valid when %s is %s", kmsKeyId, sseKey, BOS_SERVER_SIDE_ENCRYPTION_KMS)
+}
+
+func buildBosServerSideEncryptionHeaders(algorithm, kmsKeyId string) map[string]string {
+	headers := make(map[string]string)
+	if algorithm != "" {
+		headers[BOS_HEADER_SERVER_SIDE_ENCRYPTION] = algorithm
+	}
+	if algorithm == BOS_SERVER_SIDE_ENCRYPTION_KMS && kmsKeyId != "" {
+		headers[BOS_HEADER_SERVER_SIDE_ENCRYPTION_KMS_KEY_ID] = kmsKeyId
+	}
+
+	return headers
+}
+
 func (s *BosService) ListAllObjects(bucket, prefix string) ([]api.ObjectSummaryType, error) {
 	args := &api.ListObjectsArgs{
 		Prefix: prefix,
@@ -53,7 +151,9 @@ func (s *BosService) ListAllObjects(bucket, prefix string) ([]api.ObjectSummaryT
 	return objects, nil
 }
 
-func (s *BosService) resourceBaiduCloudBosBucketReadAcl(bucket string) (string, error) {
+// resourceBaiduCloudBosBucketReadAcl returns the canned acl of the bucket, and whether the bucket acl is a canned one
+// rather than a policy such as the one put by baiducloud_bos_bucket_policy
+func (s *BosService) resourceBaiduCloudBosBucketReadAcl(bucket string) (string, bool, error) {
 	action := "read bos bucket acl " + bucket
 
 	raw, err := s.client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
@@ -61,13 +161,13 @@ func (s *BosService) resourceBaiduCloudBosBucketReadAcl(bucket string) (string,
 	})
 	addDebug(action, raw)
 	if err != nil {
-		return "", err
+		return "", false, err
 	}
 
 	result, _ := raw.(*api.GetBucketAclResult)
 	aclResult := getAclByAccessControlList(result.AccessControlList)
 
-	return aclResult, nil
+	return aclResult, isBosCannedAcl(result.AccessControlList, result.Owner.Id), nil
 }
 
 func (s *BosService) resourceBaiduCloudBosBucketReadReplicationConfigure(bucket string) ([]map[string]interface{}, error) {
@@ -248,6 +348,203 @@ func (s *BosService) resourceBaiduCloudBosBucketReadCopyright(bucket string) ([]
 	return copyright, nil
 }
 
+// GetBucketTrashDir returns the trash directory of the bucket, empty if the trash is not enabled
+func (s *BosService) GetBucketTrashDir(bucket string) (string, error) {
+	action := "read bos bucket trash " + bucket
+
+	raw, err := s.client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+		return bosClient.GetBucketTrash(bucket)
+	})
+	if err != nil {
+		if IsExceptedErrors(err, []string{"NoSuchTrashDirectory"}) {
+			return "", nil
+		}
+		return "", err
+	}
+	addDebug(action, raw)
+
+	if result, ok := raw.(*api.GetBucketTrashResult); ok && result != nil {
+		return result.TrashDir, nil
+	}
+
+	return "", nil
+}
+
+func (s *BosService) resourceBaiduCloudBosBucketReadTrash(bucket string) ([]interface{}, error) {
+	trashDir, err := s.GetBucketTrashDir(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	trash := make([]interface{}, 0, 1)
+	if trashDir != "" {
+		trash = append(trash, map[string]interface{}{
+			"trash_dir": trashDir,
+		})
+	}
+
+	return trash, nil
+}
+
+func (s *BosService) GetBucketNotification(bucket string) ([]api.PutBucketNotificationSt, error) {
+	action := "read bos bucket notification " + bucket
+
+	raw, err := s.client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+		return bosClient.GetBucketNotification(bucket)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	result, _ := raw.(*api.PutBucketNotificationReq)
+	if result == nil {
+		return nil, nil
+	}
+
+	return result.Notifications, nil
+}
+
+func (s *BosService) ListBucketReplication(bucket string) ([]api.BucketReplicationType, error) {
+	action := "list bos bucket replication " + bucket
+
+	raw, err := s.client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+		return bosClient.ListBucketReplication(bucket)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	result, _ := raw.(*api.ListBucketReplicationResult)
+	if result == nil {
+		return nil, nil
+	}
+
+	return result.Rules, nil
+}
+
+func (s *BosService) GetBucketReplicationProgress(bucket, ruleId string) (*api.GetBucketReplicationProgressResult, error) {
+	action := "read bos bucket " + bucket + " replication " + ruleId + " progress"
+
+	raw, err := s.client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+		return bosClient.GetBucketReplicationProgress(bucket, ruleId)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return raw.(*api.GetBucketReplicationProgressResult), nil
+}
+
+func (s *BosService) BucketReplicationHistoryStateRefreshFunc(bucket, ruleId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		result, err := s.GetBucketReplicationProgress(bucket, ruleId)
+		if err != nil {
+			return nil, "", WrapError(err)
+		}
+
+		if result.HistoryReplicationPercent >= 100 {
+			return result, BOS_REPLICATION_HISTORY_FINISHED, nil
+		}
+		return result, BOS_REPLICATION_HISTORY_SYNCING, nil
+	}
+}
+
+func (s *BosService) GetObjectMetaWithHeaders(bucket, key string) (*api.GetObjectMetaResult, map[string]string, error) {
+	action := "read bos bucket " + bucket + " object " + key + " meta"
+
+	raw, err := s.client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+		cli := &bosRequestClient{Client: bosClient}
+		result, err := api.GetObjectMeta(cli, bucket, key)
+		return []interface{}{result, cli.responseHeaders}, err
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := raw.([]interface{})
+	headers := make(map[string]string)
+	for k, v := range results[1].(map[string]string) {
+		headers[strings.ToLower(k)] = v
+	}
+
+	return results[0].(*api.GetObjectMetaResult), headers, nil
+}
+
+func (s *BosService) GetBucketEncryption(bucket string) (*bosBucketEncryption, error) {
+	action := "read bos bucket encryption " + bucket
+
+	raw, err := s.client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+		req := &bce.BceRequest{}
+		req.SetUri(bce.URI_PREFIX + bucket)
+		req.SetMethod(http.GET)
+		req.SetParam("encryption", "")
+
+		resp := &bce.BceResponse{}
+		if err := api.SendRequest(bosClient, req, resp); err != nil {
+			return nil, err
+		}
+		if resp.IsFail() {
+			return nil, resp.ServiceError()
+		}
+		result := &bosBucketEncryption{}
+		if err := resp.ParseJsonBody(result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return raw.(*bosBucketEncryption), nil
+}
+
+func (s *BosService) PutBucketEncryption(bucket, algorithm, kmsKeyId string) error {
+	action := "put bos bucket encryption " + bucket
+
+	args := &bosBucketEncryption{
+		EncryptionAlgorithm: algorithm,
+	}
+	if algorithm == BOS_SERVER_SIDE_ENCRYPTION_KMS {
+		args.KmsKeyId = kmsKeyId
+	}
+	_, err := s.client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+		jsonBytes, err := json.Marshal(args)
+		if err != nil {
+			return nil, err
+		}
+		body, err := bce.NewBodyFromBytes(jsonBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		req := &bce.BceRequest{}
+		req.SetUri(bce.URI_PREFIX + bucket)
+		req.SetMethod(http.PUT)
+		req.SetParam("encryption", "")
+		req.SetHeader(http.CONTENT_TYPE, bce.DEFAULT_CONTENT_TYPE)
+		req.SetBody(body)
+
+		resp := &bce.BceResponse{}
+		if err := api.SendRequest(bosClient, req, resp); err != nil {
+			return nil, err
+		}
+		if resp.IsFail() {
+			return nil, resp.ServiceError()
+		}
+		defer resp.Body().Close()
+		return nil, nil
+	})
+	addDebug(action, args)
+
+	return err
+}
+
 func (s *BosService) resourceBaiduCloudBucketObjectReadAcl(bucket, key string) (string, error) {
 	action := "read bos bucket object acl, bucket: " + bucket + ", key: " + key
 
@@ -265,6 +562,269 @@ func (s *BosService) resourceBaiduCloudBucketObjectReadAcl(bucket, key string) (
 	return aclResult, nil
 }
 
+// concurrentClient returns the bos client out of the client lock, the bos client can be used concurrently
+// so that the requests of a transfer can be sent in parallel
+func (s *BosService) concurrentClient() (*bos.Client, error) {
+	raw, err := s.client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+		return bosClient, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return raw.(*bos.Client), nil
+}
+
+// transferCanceler records the first error of a concurrent transfer and cancels the requests which are not sent yet
+type transferCanceler struct {
+	once sync.Once
+	err  error
+	done chan struct{}
+}
+
+func newTransferCanceler() *transferCanceler {
+	return &transferCanceler{done: make(chan struct{})}
+}
+
+func (c *transferCanceler) cancel(err error) {
+	c.once.Do(func() {
+		c.err = err
+		close(c.done)
+	})
+}
+
+func (c *transferCanceler) canceled() bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// PutObjectsFromFiles uploads the files to the keys with at most concurrency files in flight, files maps
+// the object key to the local file path. The keys uploaded are returned even if some of the files fail,
+// so that the caller can record them.
+func (s *BosService) PutObjectsFromFiles(bucket string, files map[string]string, concurrency int,
+	buildArgs func(key, fileName string) *api.PutObjectArgs) ([]string, error) {
+	action := "Put bucket " + bucket + " objects from files"
+
+	bosClient, err := s.concurrentClient()
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_objects_sync", action, BCESDKGoERROR)
+	}
+
+	uploaded := make([]string, 0, len(files))
+	var lock sync.Mutex
+	canceler := newTransferCanceler()
+	workers := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for key, fileName := range files {
+		select {
+		case workers <- struct{}{}:
+		case <-canceler.done:
+		}
+		if canceler.canceled() {
+			break
+		}
+
+		wg.Add(1)
+		go func(key, fileName string) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+
+			body, err := bce.NewBodyFromFile(fileName)
+			if err != nil {
+				canceler.cancel(err)
+				return
+			}
+
+			args := buildArgs(key, fileName)
+			_, err = bosClient.PutObject(bucket, key, body, args)
+			addDebug("Put bucket "+bucket+" object "+key, args)
+			if err != nil {
+				canceler.cancel(err)
+				return
+			}
+
+			lock.Lock()
+			uploaded = append(uploaded, key)
+			lock.Unlock()
+		}(key, fileName)
+	}
+	wg.Wait()
+
+	if canceler.err != nil {
+		return uploaded, WrapErrorf(canceler.err, DefaultErrorMsg, "baiducloud_bos_bucket_objects_sync", action, BCESDKGoERROR)
+	}
+
+	return uploaded, nil
+}
+
+// DeleteObjects deletes the keys in batches of 1000, which is the limit of a multiple objects deletion,
+// and the keys which do not exist are ignored
+func (s *BosService) DeleteObjects(bucket string, keys []string) error {
+	action := "Delete bucket " + bucket + " objects"
+
+	for start := 0; start < len(keys); start += 1000 {
+		end := start + 1000
+		if end > len(keys) {
+			end = len(keys)
+		}
+		batch := keys[start:end]
+
+		raw, err := s.client.WithBosClient(func(bosClient *bos.Client) (i interface{}, e error) {
+			return bosClient.DeleteMultipleObjectsFromKeyList(bucket, batch)
+		})
+		addDebug(action, raw)
+		// the response body is empty if all the objects are deleted
+		if err != nil && err != io.EOF {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_objects_sync", action, BCESDKGoERROR)
+		}
+
+		if result, ok := raw.(*api.DeleteMultipleObjectsResult); ok && result != nil {
+			for _, e := range result.Errors {
+				if e.Code != "" && e.Code != "NoSuchKey" {
+					return WrapErrorf(fmt.Errorf("delete object %s failed: %s %s", e.Key, e.Code, e.Message),
+						DefaultErrorMsg, "baiducloud_bos_bucket_objects_sync", action, BCESDKGoERROR)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// MultipartUploadObject uploads the file in parts of partSize bytes with at most concurrency parts in flight,
+// and aborts the multipart upload if any part fails so that no incomplete parts are left in the bucket. The extra
+// headers are sent when initiating the upload.
+func (s *BosService) MultipartUploadObject(bucket, key, fileName, contentType string, partSize int64, concurrency int,
+	initArgs *api.InitiateMultipartUploadArgs, completeArgs *api.CompleteMultipartUploadArgs, headers map[string]string) error {
+	action := "Multipart upload bucket " + bucket + " object " + key
+
+	file, err := os.Open(fileName)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_object", action, BCESDKGoERROR)
+	}
+	defer file.Close()
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_object", action, BCESDKGoERROR)
+	}
+	size := fileInfo.Size()
+
+	// enlarge the part size if there would be more parts than allowed
+	partNum := (size + partSize - 1) / partSize
+	if partNum > bos.MAX_PART_NUMBER {
+		partSize = (size + bos.MAX_PART_NUMBER - 1) / bos.MAX_PART_NUMBER
+		partSize = (partSize + bos.MULTIPART_ALIGN - 1) / bos.MULTIPART_ALIGN * bos.MULTIPART_ALIGN
+		partNum = (size + partSize - 1) / partSize
+	}
+
+	bosClient, err := s.concurrentClient()
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_object", action, BCESDKGoERROR)
+	}
+
+	// the headers such as the server side encryption ones only need to be sent when initiating the upload
+	initResult, err := api.InitiateMultipartUpload(&bosRequestClient{Client: bosClient, headers: headers}, bucket, key,
+		contentType, initArgs)
+	addDebug(action, initResult)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_object", action, BCESDKGoERROR)
+	}
+	uploadId := initResult.UploadId
+
+	abort := func(err error) error {
+		if errAbort := bosClient.AbortMultipartUpload(bucket, key, uploadId); errAbort != nil {
+			log.Printf("[WARN] abort multipart upload %s of bucket %s object %s failed: %s", uploadId, bucket, key, errAbort)
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bos_bucket_object", action, BCESDKGoERROR)
+	}
+
+	parts := make([]api.UploadInfoType, partNum)
+	canceler := newTransferCanceler()
+	workers := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := int64(0); i < partNum && !canceler.canceled(); i++ {
+		offset := i * partSize
+		uploadSize := partSize
+		if left := size - offset; left < uploadSize {
+			uploadSize = left
+		}
+
+		body, err := bce.NewBodyFromSectionFile(file, offset, uploadSize)
+		if err != nil {
+			canceler.cancel(err)
+			break
+		}
+
+		select {
+		case workers <- struct{}{}:
+		case <-canceler.done:
+			continue
+		}
+		wg.Add(1)
+		go func(partNumber int, body *bce.Body) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+
+			if canceler.canceled() {
+				return
+			}
+			etag, err := bosClient.UploadPart(bucket, key, uploadId, partNumber, body,
+				&api.UploadPartArgs{ContentMD5: body.ContentMD5()})
+			if err != nil {
+				canceler.cancel(err)
+				return
+			}
+			parts[partNumber-1] = api.UploadInfoType{PartNumber: partNumber, ETag: etag}
+		}(int(i+1), body)
+	}
+	wg.Wait()
+
+	if canceler.err != nil {
+		return abort(canceler.err)
+	}
+
+	completeArgs.Parts = parts
+	result, err := bosClient.CompleteMultipartUploadFromStruct(bucket, key, uploadId, completeArgs)
+	addDebug(action, result)
+	if err != nil {
+		return abort(err)
+	}
+
+	return nil
+}
+
+// isBosCannedAcl checks whether the grants are the ones made by a canned acl, that is the full control of the owner
+// and the READ or READ and WRITE of everyone on the whole bucket without any condition
+func isBosCannedAcl(acList []api.GrantType, ownerId string) bool {
+	for _, acl := range acList {
+		if len(acl.Resource) > 0 || len(acl.NotResource) > 0 || len(acl.Condition.IpAddress) > 0 ||
+			len(acl.Condition.Referer.StringLike) > 0 || len(acl.Condition.Referer.StringEquals) > 0 {
+			return false
+		}
+
+		for _, grantee := range acl.Grantee {
+			switch {
+			case grantee.Id == ownerId && reflect.DeepEqual(acl.Permission, []string{"FULL_CONTROL"}):
+			case grantee.Id == "*" && (reflect.DeepEqual(acl.Permission, []string{"READ"}) ||
+				reflect.DeepEqual(acl.Permission, []string{"READ", "WRITE"})):
+			default:
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 func getAclByAccessControlList(acList []api.GrantType) string {
 	aclResult := BOS_BUCKET_ACL_PRIVATE
 
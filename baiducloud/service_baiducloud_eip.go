@@ -68,6 +68,42 @@ func (e *EipService) StopAutoRenew(ip string) error {
 	return nil
 }
 
+func (e *EipService) PurchaseReservedEip(ip string, args *eip.PurchaseReservedEipArgs) error {
+	action := "Purchase Reserved Eip " + ip
+
+	_, err := e.client.WithEipClient(func(client *eip.Client) (i interface{}, e error) {
+		return nil, client.PurchaseReservedEip(ip, args)
+	})
+	addDebug(action, args)
+
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eip", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func (e *EipService) EipDirect(ip string, direct bool) error {
+	action := "Direct Eip " + ip
+	if !direct {
+		action = "UnDirect Eip " + ip
+	}
+
+	_, err := e.client.WithEipClient(func(client *eip.Client) (i interface{}, e error) {
+		if direct {
+			return nil, client.DirectEip(ip, buildClientToken())
+		}
+		return nil, client.UnDirectEip(ip, buildClientToken())
+	})
+	addDebug(action, direct)
+
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eip", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
 func (e *EipService) EipBind(ip, instanceType, instanceId string) error {
 	action := "Bind Eip " + ip
 
@@ -0,0 +1,90 @@
+package baiducloud
+
+import (
+	"github.com/baidubce/bce-sdk-go/services/etGateway"
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	ET_GATEWAY_STATUS_CREATING  = "creating"
+	ET_GATEWAY_STATUS_RUNNING   = "running"
+	ET_GATEWAY_STATUS_UPDATING  = "updating"
+	ET_GATEWAY_STATUS_BINDING   = "binding"
+	ET_GATEWAY_STATUS_UNBINDING = "unbinding"
+	ET_GATEWAY_STATUS_UNBOUND   = "unbound"
+	ET_GATEWAY_STATUS_DELETING  = "deleting"
+	ET_GATEWAY_STATUS_DELETED   = "deleted"
+)
+
+var (
+	EtGatewayProcessingStatus = []string{
+		ET_GATEWAY_STATUS_CREATING,
+		ET_GATEWAY_STATUS_UPDATING,
+		ET_GATEWAY_STATUS_BINDING,
+		ET_GATEWAY_STATUS_UNBINDING,
+	}
+	EtGatewayAvailableStatus = []string{
+		ET_GATEWAY_STATUS_RUNNING,
+		ET_GATEWAY_STATUS_UNBOUND,
+	}
+)
+
+type EtService struct {
+	client *connectivity.BaiduClient
+}
+
+func (s *EtService) ListAllEtGateways(args *etGateway.ListEtGatewayArgs) ([]etGateway.EtGateway, error) {
+	action := "List all ET gateways for vpc " + args.VpcId
+
+	gateways := make([]etGateway.EtGateway, 0)
+	for {
+		raw, err := s.client.WithEtGatewayClient(func(etClient *etGateway.Client) (i interface{}, e error) {
+			return etClient.ListEtGateway(args)
+		})
+		if err != nil {
+			return nil, err
+		}
+		addDebug(action, raw)
+
+		result, _ := raw.(*etGateway.ListEtGatewayResult)
+		gateways = append(gateways, result.EtGateways...)
+
+		if !result.IsTruncated {
+			break
+		}
+		args.Marker = result.NextMarker
+	}
+
+	return gateways, nil
+}
+
+func (s *EtService) GetEtGatewayDetail(etGatewayId string) (*etGateway.EtGatewayDetail, error) {
+	action := "Query ET Gateway " + etGatewayId
+
+	raw, err := s.client.WithEtGatewayClient(func(etClient *etGateway.Client) (i interface{}, e error) {
+		return etClient.GetEtGatewayDetail(etGatewayId)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return raw.(*etGateway.EtGatewayDetail), nil
+}
+
+func (s *EtService) EtGatewayStateRefresh(etGatewayId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		action := "Query ET Gateway " + etGatewayId
+		result, err := s.GetEtGatewayDetail(etGatewayId)
+		if err != nil {
+			if NotFoundError(err) {
+				return 0, ET_GATEWAY_STATUS_DELETED, nil
+			}
+			return nil, "", WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_gateway", action, BCESDKGoERROR)
+		}
+
+		return result, result.Status, nil
+	}
+}
@@ -1,6 +1,8 @@
 package baiducloud
 
 import (
+	"fmt"
+
 	"github.com/baidubce/bce-sdk-go/services/vpc"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -275,6 +277,20 @@ func (s *VpcService) GetSubnetDetail(subnetID string) (*vpc.GetSubnetDetailResul
 	return result, nil
 }
 
+func (s *VpcService) GetPrivateIpAddressesInfo(args *vpc.GetVpcPrivateIpArgs) ([]vpc.VpcPrivateIpAddress, error) {
+	action := "Get private IP addresses info for VPC " + args.VpcId
+	raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
+		return vpcClient.GetPrivateIpAddressesInfo(args)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_subnet_ip_usage", action, BCESDKGoERROR)
+	}
+
+	result, _ := raw.(*vpc.VpcPrivateIpAddressesResult)
+	return result.VpcPrivateIpAddresses, nil
+}
+
 func (s *VpcService) GetRouteTableDetail(routeTableID, vpcID string) (*vpc.GetRouteTableResult, error) {
 	action := "Get route table detail " + routeTableID
 	raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
@@ -334,7 +350,7 @@ func (s *VpcService) OpenPeerConnDNSSync(d *schema.ResourceData, peerConnId stri
 		// If the dns status is one of following, then retry.
 		for _, status := range []vpc.DnsStatusType{vpc.DNS_STATUS_CLOSING, vpc.DNS_STATUS_SYNCING, vpc.DNS_STATUS_WAIT} {
 			if result.DnsStatus == status {
-				return resource.RetryableError(err)
+				return resource.RetryableError(fmt.Errorf("the dns status of peer conn %s is %s", peerConnId, status))
 			}
 		}
 
@@ -378,7 +394,7 @@ func (s *VpcService) ClosePeerConnDNSSync(d *schema.ResourceData, peerConnId str
 		// If the dns status is one of following, then retry.
 		for _, status := range []vpc.DnsStatusType{vpc.DNS_STATUS_CLOSING, vpc.DNS_STATUS_SYNCING, vpc.DNS_STATUS_WAIT} {
 			if result.DnsStatus == status {
-				return resource.RetryableError(err)
+				return resource.RetryableError(fmt.Errorf("the dns status of peer conn %s is %s", peerConnId, status))
 			}
 		}
 
@@ -393,7 +409,7 @@ func (s *VpcService) ClosePeerConnDNSSync(d *schema.ResourceData, peerConnId str
 			[]string{string(vpc.DNS_STATUS_OPEN), string(vpc.DNS_STATUS_CLOSING)},
 			[]string{string(vpc.DNS_STATUS_CLOSE)},
 			d.Timeout(schema.TimeoutUpdate),
-			s.PeerConnDNSStatusRefresh(d.Id(), role))
+			s.PeerConnDNSStatusRefresh(peerConnId, role))
 		if _, err := stateConf.WaitForState(); err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -401,3 +417,49 @@ func (s *VpcService) ClosePeerConnDNSSync(d *schema.ResourceData, peerConnId str
 		return nil
 	})
 }
+
+func (s *VpcService) ResizePeerConn(d *schema.ResourceData, peerConnId string, role vpc.PeerConnRoleType) error {
+	args := &vpc.ResizePeerConnArgs{
+		NewBandwidthInMbps: d.Get("bandwidth_in_mbps").(int),
+		ClientToken:        buildClientToken(),
+	}
+	action := "Resize Peer Conn " + peerConnId
+
+	_, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
+		return nil, vpcClient.ResizePeerConn(peerConnId, args)
+	})
+	addDebug(action, args)
+	if err != nil {
+		return err
+	}
+
+	stateConf := buildStateConf(
+		[]string{string(vpc.PEERCONN_STATUS_UPDATING)},
+		[]string{string(vpc.PEERCONN_STATUS_ACTIVE), string(vpc.PEERCONN_STATUS_CONSULTING)},
+		d.Timeout(schema.TimeoutUpdate),
+		s.PeerConnStateRefresh(peerConnId, role))
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func (s *VpcService) RenewPeerConn(d *schema.ResourceData, peerConnId string) error {
+	args := &vpc.RenewPeerConnArgs{
+		Billing: &vpc.Billing{
+			Reservation: &vpc.Reservation{
+				ReservationLength:   d.Get("renew_length").(int),
+				ReservationTimeUnit: "month",
+			},
+		},
+		ClientToken: buildClientToken(),
+	}
+	if v := d.Get("renew_time_unit").(string); v != "" {
+		args.Billing.Reservation.ReservationTimeUnit = v
+	}
+	action := "Renew Peer Conn " + peerConnId
+
+	_, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
+		return nil, vpcClient.RenewPeerConn(peerConnId, args)
+	})
+	addDebug(action, args)
+	return err
+}
@@ -0,0 +1,123 @@
+package baiducloud
+
+import (
+	"fmt"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/http"
+	"github.com/baidubce/bce-sdk-go/services/vpn"
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	VPN_STATUS_DELETED = "deleted"
+
+	VPN_CONN_STATUS_ACTIVE   = "active"
+	VPN_CONN_STATUS_BUILDING = "building"
+	VPN_CONN_STATUS_UPDATING = "updating"
+	VPN_CONN_STATUS_DELETED  = "deleted"
+)
+
+type VpnService struct {
+	client *connectivity.BaiduClient
+}
+
+func (s *VpnService) ListAllVpnGateways(args *vpn.ListVpnGatewayArgs) ([]vpn.VPN, error) {
+	action := "List all VPN gateways for vpc " + args.VpcId
+
+	vpns := make([]vpn.VPN, 0)
+	for {
+		raw, err := s.client.WithVpnClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
+			return vpnClient.ListVpnGateway(args)
+		})
+		if err != nil {
+			return nil, err
+		}
+		addDebug(action, raw)
+
+		result, _ := raw.(*vpn.ListVpnGatewayResult)
+		vpns = append(vpns, result.Vpns...)
+
+		if !result.IsTruncated {
+			break
+		}
+		args.Marker = result.NextMarker
+	}
+
+	return vpns, nil
+}
+
+func (s *VpnService) VpnGatewayStateRefresh(vpnId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		action := "Query VPN Gateway " + vpnId
+		raw, err := s.client.WithVpnClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
+			return vpnClient.GetVpnGatewayDetail(vpnId)
+		})
+		addDebug(action, raw)
+		if err != nil {
+			if NotFoundError(err) {
+				return 0, VPN_STATUS_DELETED, nil
+			}
+			return nil, "", WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_gateway", action, BCESDKGoERROR)
+		}
+
+		result, _ := raw.(*vpn.VPN)
+		return result, string(result.Status), nil
+	}
+}
+
+func (s *VpnService) GetVpnConnDetail(vpnId, vpnConnId string) (*vpn.VpnConn, error) {
+	action := "Query VPN Conn " + vpnConnId
+
+	raw, err := s.client.WithVpnClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
+		return vpnClient.ListVpnConn(vpnId)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, conn := range raw.(*vpn.ListVpnConnResult).VpnConns {
+		if conn.VpnConnId == vpnConnId {
+			return &conn, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *VpnService) VpnConnStateRefresh(vpnId, vpnConnId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		action := "Query VPN Conn " + vpnConnId
+		conn, err := s.GetVpnConnDetail(vpnId, vpnConnId)
+		if err != nil {
+			if NotFoundError(err) {
+				return 0, VPN_CONN_STATUS_DELETED, nil
+			}
+			return nil, "", WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_conn", action, BCESDKGoERROR)
+		}
+		if conn == nil {
+			return 0, VPN_CONN_STATUS_DELETED, nil
+		}
+
+		return conn, conn.Status, nil
+	}
+}
+
+// UpdateVpnConn updates the VPN conn in place, vpn.UpdateVpnConnArgs of the sdk can not be built outside the package
+func (s *VpnService) UpdateVpnConn(vpnConnId string, args *vpn.CreateVpnConnArgs) error {
+	action := "Update VPN Conn " + vpnConnId
+
+	_, err := s.client.WithVpnClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
+		return nil, bce.NewRequestBuilder(vpnClient).
+			WithURL(fmt.Sprintf("%s/vpn/vpnconn/%s", vpn.URI_PREFIX, vpnConnId)).
+			WithMethod(http.PUT).
+			WithBody(args).
+			Do()
+	})
+	addDebug(action, args)
+
+	return err
+}
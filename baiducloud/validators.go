@@ -1,8 +1,12 @@
 package baiducloud
 
 import (
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
+	"net/url"
 	"regexp"
+	"strings"
 
 	"github.com/baidubce/bce-sdk-go/services/bos/api"
 	"github.com/baidubce/bce-sdk-go/services/vpc"
@@ -145,3 +149,55 @@ func validateCFCMemorySize(v interface{}, k string) (ws []string, errors []error
 
 	return
 }
+
+func validateCertPEM(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseCertificatesPEM(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not valid PEM encoded certificate data: %s", k, err))
+	}
+
+	return
+}
+
+func validatePrivateKeyPEM(v interface{}, k string) (ws []string, errors []error) {
+	block, _ := pem.Decode([]byte(normalizePEM(v.(string))))
+	if block == nil || !strings.HasSuffix(block.Type, "PRIVATE KEY") {
+		errors = append(errors, fmt.Errorf("%q is not valid PEM encoded private key data", k))
+	}
+
+	return
+}
+
+func validateBOSNotificationEventUrl(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if strings.HasPrefix(value, BOS_NOTIFICATION_CFC_BRN_PREFIX) {
+		return
+	}
+	if u, err := url.Parse(value); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errors = append(errors, fmt.Errorf("%q must be a http(s) webhook url or a CFC function brn starting with %s, got %s",
+			k, BOS_NOTIFICATION_CFC_BRN_PREFIX, value))
+	}
+
+	return
+}
+
+func validateBOSBucketPolicy(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseBosBucketPolicy(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid bucket policy: %s", k, err))
+	}
+
+	return
+}
+
+func validateBOSClientSideEncryptionKey(v interface{}, k string) (ws []string, errors []error) {
+	key, err := base64.StdEncoding.DecodeString(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be base64 encoded: %s", k, err))
+		return
+	}
+	if l := len(key); l != 16 && l != 24 && l != 32 {
+		errors = append(errors, fmt.Errorf("%q must be a 16, 24 or 32 bytes AES key, got %d bytes", k, l))
+	}
+
+	return
+}